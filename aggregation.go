@@ -0,0 +1,87 @@
+package period
+
+import (
+	"time"
+)
+
+type Sample struct {
+	Period Period
+	Value  float64
+}
+
+// Integral sums value × seconds of every segment clipped to the window, uncovered parts count as zero
+func Integral(m *IntervalMap[float64], window Period) float64 {
+	integral, _ := integrate(m, window)
+
+	return integral
+}
+
+// WeightedAverage averages the values weighted by their duration inside the window, ok is false when nothing is covered
+func WeightedAverage(m *IntervalMap[float64], window Period) (float64, bool) {
+	integral, covered := integrate(m, window)
+	if covered <= 0 {
+		return 0, false
+	}
+
+	return integral / covered.Seconds(), true
+}
+
+// Resample splits the window into buckets of the given duration and averages each one, uncovered buckets are skipped
+func Resample(m *IntervalMap[float64], window Period, bucket time.Duration) []Sample {
+	var samples []Sample
+
+	for _, period := range splitByDuration(window, bucket) {
+		if value, ok := WeightedAverage(m, period); ok {
+			samples = append(samples, Sample{Period: period, Value: value})
+		}
+	}
+
+	return samples
+}
+
+func integrate(m *IntervalMap[float64], window Period) (float64, time.Duration) {
+	var (
+		integral float64
+		covered  time.Duration
+	)
+
+	m.Range(
+		window, func(period Period, value float64) bool {
+			interval := period.GetDateInterval()
+			integral += value * interval.Seconds()
+			covered += interval
+
+			return true
+		},
+	)
+
+	return integral, covered
+}
+
+// splitByDuration cuts the period into "[)" chunks, the first and the last chunk keep the period's own bounds
+func splitByDuration(period Period, duration time.Duration) []Period {
+	if duration <= 0 || period.isEmpty() {
+		return nil
+	}
+
+	boundaryType := period.GetBoundaryType()
+	var periods []Period
+
+	for startDate := period.startDate; startDate.Before(period.endDate); startDate = startDate.Add(duration) {
+		endDate := startDate.Add(duration)
+		bound := IncludeStartExcludeEnd
+
+		if startDate.Equal(period.startDate) {
+			bound = boundaryReplaceStart(bound, boundaryType)
+		}
+
+		if !endDate.Before(period.endDate) {
+			endDate = period.endDate
+			bound = boundaryReplaceEnd(bound, boundaryType)
+		}
+
+		periods = append(periods, NewPeriod(startDate, endDate, bound))
+	}
+
+	return periods
+}
@@ -0,0 +1,152 @@
+package period
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func newAggregationMap() *IntervalMap[float64] {
+	m := NewIntervalMap[float64]()
+	m.Set(NewDefaultPeriod(hour(0), hour(2)), 10)
+	m.Set(NewDefaultPeriod(hour(2), hour(3)), 40)
+	m.Set(NewDefaultPeriod(hour(5), hour(6)), 1)
+
+	return m
+}
+
+func TestIntegral(t *testing.T) {
+	tests := []struct {
+		name   string
+		window Period
+		want   float64
+	}{
+		{
+			name:   "Integral_WithWholeMap",
+			window: NewDefaultPeriod(hour(0), hour(6)),
+			want:   (10*2 + 40 + 1) * 3600,
+		},
+		{
+			name:   "Integral_WithPartialOverlap",
+			window: NewDefaultPeriod(hour(1), hour(2).Add(30*time.Minute)),
+			want:   10*3600 + 40*1800,
+		},
+		{
+			name:   "Integral_WithUncoveredWindow",
+			window: NewDefaultPeriod(hour(3), hour(5)),
+			want:   0,
+		},
+	}
+
+	m := newAggregationMap()
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.InDelta(t, tt.want, Integral(m, tt.window), 1e-9)
+			},
+		)
+	}
+}
+
+func TestWeightedAverage(t *testing.T) {
+	tests := []struct {
+		name   string
+		window Period
+		want   float64
+		wantOk bool
+	}{
+		{
+			name:   "WeightedAverage_WithGapIgnored",
+			window: NewDefaultPeriod(hour(0), hour(6)),
+			want:   61.0 / 4,
+			wantOk: true,
+		},
+		{
+			name:   "WeightedAverage_WithPartialOverlap",
+			window: NewDefaultPeriod(hour(1), hour(3)),
+			want:   25,
+			wantOk: true,
+		},
+		{
+			name:   "WeightedAverage_WithUncoveredWindow",
+			window: NewDefaultPeriod(hour(3), hour(5)),
+			want:   0,
+			wantOk: false,
+		},
+		{
+			name:   "WeightedAverage_WithOnlyTouchingBound",
+			window: NewIncludeAllPeriod(hour(3), hour(5)),
+			want:   0,
+			wantOk: false,
+		},
+	}
+
+	m := newAggregationMap()
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, ok := WeightedAverage(m, tt.window)
+				assert.Equal(t, tt.wantOk, ok)
+				assert.InDelta(t, tt.want, got, 1e-9)
+			},
+		)
+	}
+}
+
+func TestResample(t *testing.T) {
+	m := newAggregationMap()
+	window := NewIncludeAllPeriod(hour(1).Add(30*time.Minute), hour(6))
+
+	got := Resample(m, window, 90*time.Minute)
+	want := []Sample{
+		{Period: NewDefaultPeriod(hour(1).Add(30*time.Minute), hour(3)), Value: 30},
+		{Period: NewIncludeAllPeriod(hour(4).Add(30*time.Minute), hour(6)), Value: 1},
+	}
+
+	assert.Equal(t, len(want), len(got))
+	for i := range want {
+		assert.True(t, want[i].Period.Equals(got[i].Period), "%v != %v", want[i].Period, got[i].Period)
+		assert.InDelta(t, want[i].Value, got[i].Value, 1e-9)
+	}
+
+	assert.Nil(t, Resample(m, window, 0))
+}
+
+func TestSplitByDuration(t *testing.T) {
+	tests := []struct {
+		name     string
+		period   Period
+		duration time.Duration
+		want     []Period
+	}{
+		{
+			name:     "SplitByDuration_WithExcludeAll",
+			period:   NewPeriod(hour(0), hour(3), ExcludeAll),
+			duration: 2 * time.Hour,
+			want: []Period{
+				NewPeriod(hour(0), hour(2), ExcludeAll),
+				NewPeriod(hour(2), hour(3), IncludeStartExcludeEnd),
+			},
+		},
+		{
+			name:     "SplitByDuration_WithSingleChunk",
+			period:   NewPeriod(hour(0), hour(1), ExcludeStartIncludeEnd),
+			duration: 2 * time.Hour,
+			want: []Period{
+				NewPeriod(hour(0), hour(1), ExcludeStartIncludeEnd),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got := splitByDuration(tt.period, tt.duration)
+				assert.Equal(t, len(tt.want), len(got))
+				for i := range tt.want {
+					assert.True(t, tt.want[i].Equals(got[i]), "%v != %v", tt.want[i], got[i])
+				}
+			},
+		)
+	}
+}
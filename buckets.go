@@ -0,0 +1,107 @@
+package period
+
+import (
+	"sort"
+	"time"
+)
+
+// Buckets assigns date points to the periods of a sequence, indexes always refer to the original sequence order
+type Buckets struct {
+	periods []Period
+	indexes []int
+	maxEnds []time.Time
+}
+
+func NewBuckets(sequence Sequence) Buckets {
+	indexes := make([]int, len(sequence.intervals))
+	for i := range indexes {
+		indexes[i] = i
+	}
+
+	sort.SliceStable(
+		indexes, func(i, j int) bool {
+			return sequence.sortByStartDate(sequence.intervals[indexes[i]], sequence.intervals[indexes[j]]) < 0
+		},
+	)
+
+	periods := make([]Period, len(indexes))
+	maxEnds := make([]time.Time, len(indexes))
+
+	for i, index := range indexes {
+		periods[i] = sequence.intervals[index]
+		maxEnds[i] = periods[i].endDate
+
+		if i > 0 && maxEnds[i-1].After(maxEnds[i]) {
+			maxEnds[i] = maxEnds[i-1]
+		}
+	}
+
+	return Buckets{periods: periods, indexes: indexes, maxEnds: maxEnds}
+}
+
+func (b Buckets) Count() int {
+	return len(b.periods)
+}
+
+func (b Buckets) Get(index int) Period {
+	for i, original := range b.indexes {
+		if original == index {
+			return b.periods[i]
+		}
+	}
+
+	return Period{}
+}
+
+// Index returns the bucket containing the date, or -1, when buckets overlap the one starting last wins
+func (b Buckets) Index(date time.Time) int {
+	position := sort.Search(
+		len(b.periods), func(i int) bool {
+			return b.periods[i].startDate.After(date)
+		},
+	)
+
+	for i := position - 1; i >= 0 && !b.maxEnds[i].Before(date); i-- {
+		period := b.periods[i]
+		if period.containsDatePoint(date, period.GetBoundaryType()) {
+			return b.indexes[i]
+		}
+	}
+
+	return -1
+}
+
+func (b Buckets) Assign(times []time.Time) []int {
+	indexes := make([]int, len(times))
+
+	for i, date := range times {
+		indexes[i] = b.Index(date)
+	}
+
+	return indexes
+}
+
+// Histogram counts the date points falling in each bucket, points outside every bucket are dropped
+func (b Buckets) Histogram(times []time.Time) []int {
+	counts := make([]int, len(b.periods))
+
+	for _, date := range times {
+		if index := b.Index(date); index >= 0 {
+			counts[index]++
+		}
+	}
+
+	return counts
+}
+
+func GroupBy[T any](b Buckets, items []T, timeOf func(T) time.Time) [][]T {
+	groups := make([][]T, len(b.periods))
+
+	for _, item := range items {
+		if index := b.Index(timeOf(item)); index >= 0 {
+			groups[index] = append(groups[index], item)
+		}
+	}
+
+	return groups
+}
@@ -0,0 +1,118 @@
+package period
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestBucketsAssign(t *testing.T) {
+	tests := []struct {
+		name     string
+		sequence Sequence
+		times    []time.Time
+		want     []int
+	}{
+		{
+			name: "Assign_WithDefaultBuckets",
+			sequence: NewSequence(
+				NewDefaultPeriod(hour(1), hour(2)),
+				NewDefaultPeriod(hour(0), hour(1)),
+				NewDefaultPeriod(hour(2), hour(3)),
+			),
+			times: []time.Time{hour(0), hour(1), hour(2).Add(time.Minute), hour(3)},
+			want:  []int{1, 0, 2, -1},
+		},
+		{
+			name: "Assign_WithExcludeStartIncludeEnd",
+			sequence: NewSequence(
+				NewPeriod(hour(0), hour(1), ExcludeStartIncludeEnd),
+				NewPeriod(hour(1), hour(2), ExcludeStartIncludeEnd),
+			),
+			times: []time.Time{hour(0), hour(1), hour(2)},
+			want:  []int{-1, 0, 1},
+		},
+		{
+			name: "Assign_WithGapBetweenBuckets",
+			sequence: NewSequence(
+				NewDefaultPeriod(hour(0), hour(1)),
+				NewDefaultPeriod(hour(5), hour(6)),
+			),
+			times: []time.Time{hour(3), hour(5)},
+			want:  []int{-1, 1},
+		},
+		{
+			name: "Assign_WithNestedBuckets",
+			sequence: NewSequence(
+				NewDefaultPeriod(hour(0), hour(10)),
+				NewDefaultPeriod(hour(2), hour(3)),
+			),
+			times: []time.Time{hour(2), hour(5)},
+			want:  []int{1, 0},
+		},
+		{
+			name:     "Assign_WithoutBuckets",
+			sequence: NewSequence(),
+			times:    []time.Time{hour(0)},
+			want:     []int{-1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.want, NewBuckets(tt.sequence).Assign(tt.times))
+			},
+		)
+	}
+}
+
+func TestBucketsHistogram(t *testing.T) {
+	sequence := NewSequence(
+		NewDefaultPeriod(hour(1), hour(2)),
+		NewDefaultPeriod(hour(0), hour(1)),
+	)
+	buckets := NewBuckets(sequence)
+
+	times := []time.Time{
+		hour(0),
+		hour(0).Add(30 * time.Minute),
+		hour(1),
+		hour(4),
+	}
+
+	assert.Equal(t, []int{1, 2}, buckets.Histogram(times))
+	assert.Equal(t, 2, buckets.Count())
+	assert.True(t, sequence.Get(0).Equals(buckets.Get(0)))
+	assert.True(t, buckets.Get(5).IsZero())
+	assert.Equal(t, hour(1), sequence.Get(0).GetStartDate())
+}
+
+func TestGroupBy(t *testing.T) {
+	type event struct {
+		name string
+		at   time.Time
+	}
+
+	buckets := NewBuckets(
+		NewSequence(
+			NewDefaultPeriod(hour(0), hour(1)),
+			NewDefaultPeriod(hour(1), hour(2)),
+		),
+	)
+
+	events := []event{
+		{name: "a", at: hour(0)},
+		{name: "b", at: hour(1)},
+		{name: "c", at: hour(0).Add(time.Minute)},
+		{name: "d", at: hour(9)},
+	}
+
+	groups := GroupBy(
+		buckets, events, func(e event) time.Time {
+			return e.at
+		},
+	)
+
+	assert.Equal(t, [][]event{{events[0], events[2]}, {events[1]}}, groups)
+}
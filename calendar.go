@@ -0,0 +1,78 @@
+package period
+
+import (
+	"time"
+)
+
+// CalendarLength is the wall clock length of a period, a DST day is 1 day even though it lasts 23 or 25 hours
+type CalendarLength struct {
+	Years       int
+	Months      int
+	Days        int
+	Hours       int
+	Minutes     int
+	Seconds     int
+	Nanoseconds int
+}
+
+// Elapsed returns the absolute time elapsed between the start and the end of the period
+func (p Period) Elapsed() time.Duration {
+	return p.endDate.Sub(p.startDate)
+}
+
+// CalendarLength returns the wall clock difference between the dates in the location of the start date,
+// months are counted first and clamped to the end of shorter months, e.g. Jan 31 to Feb 28 is 1 month
+func (p Period) CalendarLength() CalendarLength {
+	start, end := wallClock(p.startDate), wallClock(p.endDate.In(p.startDate.Location()))
+
+	months := (end.Year()-start.Year())*12 + int(end.Month()-start.Month())
+	anchor := addMonthsClamped(start, months)
+	if anchor.After(end) {
+		months--
+		anchor = addMonthsClamped(start, months)
+	}
+
+	rest := end.Sub(anchor)
+
+	return CalendarLength{
+		Years:       months / 12,
+		Months:      months % 12,
+		Days:        int(rest / (24 * time.Hour)),
+		Hours:       int(rest % (24 * time.Hour) / time.Hour),
+		Minutes:     int(rest % time.Hour / time.Minute),
+		Seconds:     int(rest % time.Minute / time.Second),
+		Nanoseconds: int(rest % time.Second),
+	}
+}
+
+// wallClock keeps the clock reading of t in UTC, so that differences ignore offset changes
+func wallClock(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
+
+func addMonthsClamped(t time.Time, months int) time.Time {
+	year, month, day := t.Date()
+
+	if last := time.Date(year, month+time.Month(months)+1, 0, 0, 0, 0, 0, t.Location()).Day(); day > last {
+		day = last
+	}
+
+	return time.Date(year, month+time.Month(months), day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+}
+
+// SplitByOffset cuts the period at every UTC offset change of the start date's location, e.g. DST transitions
+func (p Period) SplitByOffset() Sequence {
+	var cuts []time.Time
+
+	for date := p.startDate; ; {
+		_, end := date.ZoneBounds()
+		if end.IsZero() || !end.Before(p.endDate) {
+			break
+		}
+
+		cuts = append(cuts, end)
+		date = end
+	}
+
+	return p.SplitAt(cuts...)
+}
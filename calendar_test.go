@@ -0,0 +1,124 @@
+package period
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestPeriodCalendarLength(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		period      Period
+		want        CalendarLength
+		wantElapsed time.Duration
+	}{
+		{
+			name:        "CalendarLength_WithSpringForwardDay",
+			period:      Period{}.fromDay(2023, 3, 12, IncludeStartExcludeEnd, newYork),
+			want:        CalendarLength{Days: 1},
+			wantElapsed: 23 * time.Hour,
+		},
+		{
+			name:        "CalendarLength_WithFallBackDay",
+			period:      Period{}.fromDay(2023, 11, 5, IncludeStartExcludeEnd, newYork),
+			want:        CalendarLength{Days: 1},
+			wantElapsed: 25 * time.Hour,
+		},
+		{
+			name: "CalendarLength_WithBorrowing",
+			period: NewDefaultPeriod(
+				time.Date(2023, 1, 31, 22, 30, 0, 0, time.UTC),
+				time.Date(2024, 3, 1, 1, 15, 0, 500, time.UTC),
+			),
+			want:        CalendarLength{Years: 1, Months: 1, Hours: 2, Minutes: 45, Nanoseconds: 500},
+			wantElapsed: time.Date(2024, 3, 1, 1, 15, 0, 500, time.UTC).Sub(time.Date(2023, 1, 31, 22, 30, 0, 0, time.UTC)),
+		},
+		{
+			name: "CalendarLength_WithClampedMonth",
+			period: NewDefaultPeriod(
+				time.Date(2023, 1, 31, 0, 0, 0, 0, time.UTC),
+				time.Date(2023, 3, 30, 0, 0, 0, 0, time.UTC),
+			),
+			want:        CalendarLength{Months: 1, Days: 30},
+			wantElapsed: 58 * 24 * time.Hour,
+		},
+		{
+			name: "CalendarLength_WithEndInOtherLocation",
+			period: NewDefaultPeriod(
+				time.Date(2023, 6, 1, 9, 0, 0, 0, newYork),
+				time.Date(2023, 6, 1, 15, 0, 0, 0, time.UTC),
+			),
+			want:        CalendarLength{Hours: 2},
+			wantElapsed: 2 * time.Hour,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.want, tt.period.CalendarLength())
+				assert.Equal(t, tt.wantElapsed, tt.period.Elapsed())
+			},
+		)
+	}
+}
+
+func TestPeriodSplitByOffset(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		period Period
+		want   []time.Duration
+	}{
+		{
+			name: "SplitByOffset_WithFallBackNight",
+			period: NewDefaultPeriod(
+				time.Date(2023, 11, 4, 22, 0, 0, 0, newYork),
+				time.Date(2023, 11, 5, 6, 0, 0, 0, newYork),
+			),
+			want: []time.Duration{4 * time.Hour, 5 * time.Hour},
+		},
+		{
+			name: "SplitByOffset_WithWholeYear",
+			period: NewDefaultPeriod(
+				time.Date(2023, 1, 1, 0, 0, 0, 0, newYork),
+				time.Date(2024, 1, 1, 0, 0, 0, 0, newYork),
+			),
+			want: []time.Duration{
+				time.Date(2023, 3, 12, 7, 0, 0, 0, time.UTC).Sub(time.Date(2023, 1, 1, 5, 0, 0, 0, time.UTC)),
+				time.Date(2023, 11, 5, 6, 0, 0, 0, time.UTC).Sub(time.Date(2023, 3, 12, 7, 0, 0, 0, time.UTC)),
+				time.Date(2024, 1, 1, 5, 0, 0, 0, time.UTC).Sub(time.Date(2023, 11, 5, 6, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name: "SplitByOffset_WithFixedZone",
+			period: NewDefaultPeriod(
+				time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC),
+			),
+			want: []time.Duration{time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC).Sub(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got := tt.period.SplitByOffset()
+				assert.Equal(t, len(tt.want), got.Count())
+				for i, piece := range got.GetInterval() {
+					assert.Equal(t, tt.want[i], piece.Elapsed())
+
+					_, startOffset := piece.GetStartDate().Zone()
+					_, endOffset := piece.GetEndDate().Add(-time.Nanosecond).Zone()
+					assert.Equal(t, startOffset, endOffset)
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,347 @@
+package period
+
+import (
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// label alignments 标签对齐方式
+const (
+	AlignLeft   = "left"
+	AlignRight  = "right"
+	AlignCenter = "center"
+)
+
+var chartColors = map[string]string{
+	"black":   "\033[30m",
+	"red":     "\033[31m",
+	"green":   "\033[32m",
+	"yellow":  "\033[33m",
+	"blue":    "\033[34m",
+	"magenta": "\033[35m",
+	"cyan":    "\033[36m",
+	"white":   "\033[37m",
+}
+
+const chartColorReset = "\033[0m"
+
+type datasetItem struct {
+	label    string
+	sequence Sequence
+}
+
+type Dataset struct {
+	items []datasetItem
+}
+
+func NewDataset() Dataset {
+	return Dataset{}
+}
+
+func (d Dataset) Append(label string, periods ...Period) Dataset {
+	return d.AppendSequence(label, NewSequence(periods...))
+}
+
+func (d Dataset) AppendSequence(label string, sequence Sequence) Dataset {
+	d.items = append(append([]datasetItem{}, d.items...), datasetItem{label: label, sequence: sequence})
+
+	return d
+}
+
+func (d Dataset) Count() int {
+	return len(d.items)
+}
+
+func (d Dataset) IsEmpty() bool {
+	return len(d.items) == 0
+}
+
+func (d Dataset) Labels() []string {
+	labels := make([]string, 0, len(d.items))
+
+	for _, item := range d.items {
+		labels = append(labels, item.label)
+	}
+
+	return labels
+}
+
+// Boundaries returns the period covering every period of the dataset
+func (d Dataset) Boundaries() (Period, bool) {
+	var boundaries Sequence
+
+	for _, item := range d.items {
+		if period, ok := item.sequence.Boundaries(); ok {
+			boundaries = boundaries.Push(period)
+		}
+	}
+
+	return boundaries.Boundaries()
+}
+
+type GanttChartConfig struct {
+	width           int
+	body            string
+	space           string
+	startIncluded   string
+	startExcluded   string
+	endIncluded     string
+	endExcluded     string
+	labelAlignment  string
+	colors          []string
+	gapSize         int
+	leftMarginSize  int
+	rightMarginSize int
+	disableColors   bool
+	disableLabels   bool
+	boundaryLayout  string
+}
+
+func NewGanttChartConfig() GanttChartConfig {
+	return GanttChartConfig{
+		width:           60,
+		body:            "-",
+		space:           " ",
+		startIncluded:   IncludeStart,
+		startExcluded:   ExcludeStart,
+		endIncluded:     IncludeEnd,
+		endExcluded:     ExcludeEnd,
+		labelAlignment:  AlignLeft,
+		gapSize:         1,
+		leftMarginSize:  1,
+		rightMarginSize: 1,
+		disableColors:   true,
+	}
+}
+
+func (c GanttChartConfig) WithWidth(width int) GanttChartConfig {
+	if width > 1 {
+		c.width = width
+	}
+
+	return c
+}
+
+func (c GanttChartConfig) WithBody(body string) GanttChartConfig {
+	c.body = firstChartGlyph(body, c.body)
+
+	return c
+}
+
+func (c GanttChartConfig) WithSpace(space string) GanttChartConfig {
+	c.space = firstChartGlyph(space, c.space)
+
+	return c
+}
+
+func (c GanttChartConfig) WithStartGlyphs(included, excluded string) GanttChartConfig {
+	c.startIncluded = firstChartGlyph(included, c.startIncluded)
+	c.startExcluded = firstChartGlyph(excluded, c.startExcluded)
+
+	return c
+}
+
+func (c GanttChartConfig) WithEndGlyphs(included, excluded string) GanttChartConfig {
+	c.endIncluded = firstChartGlyph(included, c.endIncluded)
+	c.endExcluded = firstChartGlyph(excluded, c.endExcluded)
+
+	return c
+}
+
+func (c GanttChartConfig) WithLabelAlignment(alignment string) GanttChartConfig {
+	switch alignment {
+	case AlignLeft, AlignRight, AlignCenter:
+		c.labelAlignment = alignment
+	}
+
+	return c
+}
+
+// WithColors enables ANSI colors, the named colors (red, green, ...) are used in turn for every row
+func (c GanttChartConfig) WithColors(colors ...string) GanttChartConfig {
+	c.colors = nil
+
+	for _, color := range colors {
+		if _, ok := chartColors[color]; ok {
+			c.colors = append(c.colors, color)
+		}
+	}
+
+	c.disableColors = len(c.colors) == 0
+
+	return c
+}
+
+func (c GanttChartConfig) WithGapSize(gapSize int) GanttChartConfig {
+	if gapSize >= 0 {
+		c.gapSize = gapSize
+	}
+
+	return c
+}
+
+func (c GanttChartConfig) WithMargins(left, right int) GanttChartConfig {
+	if left >= 0 {
+		c.leftMarginSize = left
+	}
+
+	if right >= 0 {
+		c.rightMarginSize = right
+	}
+
+	return c
+}
+
+func (c GanttChartConfig) WithoutLabels() GanttChartConfig {
+	c.disableLabels = true
+
+	return c
+}
+
+// WithBoundaryLabels adds a last row showing the start and end dates of the chart formatted with the given layout
+func (c GanttChartConfig) WithBoundaryLabels(layout string) GanttChartConfig {
+	c.boundaryLayout = layout
+
+	return c
+}
+
+func firstChartGlyph(glyph, fallback string) string {
+	r, size := utf8.DecodeRuneInString(glyph)
+	if size == 0 || r == utf8.RuneError {
+		return fallback
+	}
+
+	return string(r)
+}
+
+type GanttChart struct {
+	config GanttChartConfig
+}
+
+func NewGanttChart(config GanttChartConfig) GanttChart {
+	return GanttChart{config: config}
+}
+
+func (g GanttChart) Stroke(w io.Writer, dataset Dataset) error {
+	boundaries, ok := dataset.Boundaries()
+	if !ok {
+		return nil
+	}
+
+	labelWidth := 0
+	if !g.config.disableLabels {
+		for _, label := range dataset.Labels() {
+			labelWidth = max(labelWidth, utf8.RuneCountInString(label))
+		}
+	}
+
+	var builder strings.Builder
+	for index, item := range dataset.items {
+		builder.WriteString(strings.Repeat(" ", g.config.leftMarginSize))
+
+		if !g.config.disableLabels {
+			builder.WriteString(g.alignLabel(item.label, labelWidth))
+			builder.WriteString(strings.Repeat(" ", g.config.gapSize))
+		}
+
+		line := g.drawSequence(item.sequence, boundaries)
+		if !g.config.disableColors {
+			line = chartColors[g.config.colors[index%len(g.config.colors)]] + line + chartColorReset
+		}
+
+		builder.WriteString(line)
+		builder.WriteString(strings.Repeat(" ", g.config.rightMarginSize))
+		builder.WriteString("\n")
+	}
+
+	if g.config.boundaryLayout != "" {
+		builder.WriteString(strings.Repeat(" ", g.config.leftMarginSize))
+
+		if !g.config.disableLabels {
+			builder.WriteString(strings.Repeat(" ", labelWidth+g.config.gapSize))
+		}
+
+		builder.WriteString(g.boundaryLabels(boundaries))
+		builder.WriteString("\n")
+	}
+
+	_, err := io.WriteString(w, builder.String())
+
+	return err
+}
+
+func (g GanttChart) alignLabel(label string, width int) string {
+	padding := width - utf8.RuneCountInString(label)
+
+	switch g.config.labelAlignment {
+	case AlignRight:
+		return strings.Repeat(" ", padding) + label
+	case AlignCenter:
+		left := padding / 2
+		return strings.Repeat(" ", left) + label + strings.Repeat(" ", padding-left)
+	default:
+		return label + strings.Repeat(" ", padding)
+	}
+}
+
+func (g GanttChart) boundaryLabels(boundaries Period) string {
+	start := boundaries.startDate.Format(g.config.boundaryLayout)
+	end := boundaries.endDate.Format(g.config.boundaryLayout)
+	padding := g.config.width - utf8.RuneCountInString(start) - utf8.RuneCountInString(end)
+
+	if padding < 1 {
+		return start + " " + end
+	}
+
+	return start + strings.Repeat(" ", padding) + end
+}
+
+// column maps a date point of the boundaries on the [0, width-1] scale
+func (g GanttChart) column(boundaries Period, period Period, start bool) int {
+	total := boundaries.endDate.Sub(boundaries.startDate)
+	if total <= 0 {
+		return 0
+	}
+
+	date := period.endDate
+	if start {
+		date = period.startDate
+	}
+
+	ratio := float64(date.Sub(boundaries.startDate)) / float64(total)
+
+	return int(ratio*float64(g.config.width-1) + 0.5)
+}
+
+func (g GanttChart) drawSequence(sequence Sequence, boundaries Period) string {
+	cells := make([]string, g.config.width)
+	for i := range cells {
+		cells[i] = g.config.space
+	}
+
+	for _, period := range sequence.intervals {
+		startColumn := g.column(boundaries, period, true)
+		endColumn := g.column(boundaries, period, false)
+
+		for i := startColumn + 1; i < endColumn; i++ {
+			cells[i] = g.config.body
+		}
+
+		cells[startColumn] = g.config.startExcluded
+		if boundaryIsStartIncluded(period.GetBoundaryType()) {
+			cells[startColumn] = g.config.startIncluded
+		}
+
+		if endColumn == startColumn {
+			continue
+		}
+
+		cells[endColumn] = g.config.endExcluded
+		if boundaryIsEndIncluded(period.GetBoundaryType()) {
+			cells[endColumn] = g.config.endIncluded
+		}
+	}
+
+	return strings.Join(cells, "")
+}
@@ -0,0 +1,171 @@
+package period
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDatasetBoundaries(t *testing.T) {
+	tests := []struct {
+		name    string
+		dataset Dataset
+		want    Period
+		wantOk  bool
+	}{
+		{
+			name:    "Boundaries_WithEmptyDataset",
+			dataset: NewDataset(),
+			want:    Period{},
+			wantOk:  false,
+		},
+		{
+			name: "Boundaries_WithSeveralSequences",
+			dataset: NewDataset().
+				Append("A", NewDefaultPeriod(time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC), time.Date(2023, 1, 5, 0, 0, 0, 0, time.UTC))).
+				Append("B", NewIncludeAllPeriod(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2023, 1, 3, 0, 0, 0, 0, time.UTC))),
+			want:   NewDefaultPeriod(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2023, 1, 5, 0, 0, 0, 0, time.UTC)),
+			wantOk: true,
+		},
+		{
+			name: "Boundaries_WithSharedStartMixedBounds",
+			dataset: NewDataset().
+				Append("A", NewPeriod(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2023, 1, 3, 0, 0, 0, 0, time.UTC), ExcludeAll)).
+				Append("B", NewDefaultPeriod(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC))),
+			want:   NewDefaultPeriod(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2023, 1, 3, 0, 0, 0, 0, time.UTC)),
+			wantOk: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, ok := tt.dataset.Boundaries()
+				assert.Equal(t, tt.wantOk, ok)
+				assert.True(t, tt.want.Equals(got))
+			},
+		)
+	}
+}
+
+func TestDatasetImmutable(t *testing.T) {
+	dataset := NewDataset().Append("A")
+	_ = dataset.Append("B")
+
+	assert.Equal(t, []string{"A"}, dataset.Labels())
+	assert.Equal(t, 1, dataset.Count())
+	assert.False(t, dataset.IsEmpty())
+}
+
+func TestGanttChartStroke(t *testing.T) {
+	day := func(d int) time.Time {
+		return time.Date(2023, 1, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	dataset := NewDataset().
+		Append("A", NewDefaultPeriod(day(1), day(11))).
+		Append("BB", NewPeriod(day(6), day(11), ExcludeStartIncludeEnd)).
+		AppendSequence("C", NewSequence(NewIncludeAllPeriod(day(1), day(3)), NewPeriod(day(8), day(10), ExcludeAll)))
+
+	tests := []struct {
+		name    string
+		config  GanttChartConfig
+		dataset Dataset
+		want    []string
+	}{
+		{
+			name:    "Stroke_WithEmptyDataset",
+			config:  NewGanttChartConfig(),
+			dataset: NewDataset(),
+			want:    nil,
+		},
+		{
+			name:    "Stroke_WithDefaultGlyphs",
+			config:  NewGanttChartConfig().WithWidth(11),
+			dataset: dataset,
+			want: []string{
+				" A  [---------) ",
+				" BB      (----] ",
+				" C  [-]    (-)  ",
+			},
+		},
+		{
+			name:    "Stroke_WithRightAlignedLabels",
+			config:  NewGanttChartConfig().WithWidth(11).WithLabelAlignment(AlignRight).WithMargins(0, 0),
+			dataset: dataset,
+			want: []string{
+				" A [---------)",
+				"BB      (----]",
+				" C [-]    (-) ",
+			},
+		},
+		{
+			name: "Stroke_WithCustomGlyphs",
+			config: NewGanttChartConfig().WithWidth(11).WithoutLabels().WithMargins(0, 0).
+				WithBody("═").WithSpace("·").WithStartGlyphs("▕", "▏").WithEndGlyphs("▏", "▕"),
+			dataset: dataset,
+			want: []string{
+				"▕═════════▕",
+				"·····▏════▏",
+				"▕═▏····▏═▕·",
+			},
+		},
+		{
+			name:    "Stroke_WithBoundaryLabels",
+			config:  NewGanttChartConfig().WithWidth(12).WithoutLabels().WithMargins(0, 0).WithBoundaryLabels("01/02"),
+			dataset: NewDataset().Append("A", NewDefaultPeriod(day(1), day(12))),
+			want: []string{
+				"[----------)",
+				"01/01  01/12",
+			},
+		},
+		{
+			name:    "Stroke_WithColors",
+			config:  NewGanttChartConfig().WithWidth(3).WithoutLabels().WithMargins(0, 0).WithColors("red", "unknown"),
+			dataset: NewDataset().Append("A", NewDefaultPeriod(day(1), day(3))),
+			want: []string{
+				"\033[31m[-)\033[0m",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				var buffer bytes.Buffer
+				err := NewGanttChart(tt.config).Stroke(&buffer, tt.dataset)
+				assert.NoError(t, err)
+
+				want := ""
+				if tt.want != nil {
+					want = strings.Join(tt.want, "\n") + "\n"
+				}
+				assert.Equal(t, want, buffer.String())
+			},
+		)
+	}
+}
+
+func TestGanttChartAlignLabel(t *testing.T) {
+	tests := []struct {
+		name      string
+		alignment string
+		want      string
+	}{
+		{name: "AlignLabel_WithLeftAlignment", alignment: AlignLeft, want: "ab   "},
+		{name: "AlignLabel_WithRightAlignment", alignment: AlignRight, want: "   ab"},
+		{name: "AlignLabel_WithCenterAlignment", alignment: AlignCenter, want: " ab  "},
+		{name: "AlignLabel_WithUnknownAlignment", alignment: "top", want: "ab   "},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				chart := NewGanttChart(NewGanttChartConfig().WithLabelAlignment(tt.alignment))
+				assert.Equal(t, tt.want, chart.alignLabel("ab", 5))
+			},
+		)
+	}
+}
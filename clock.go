@@ -0,0 +1,30 @@
+package period
+
+import (
+	"time"
+)
+
+// Clock supplies the current time to relative periods, so that they can be tested
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+var SystemClock Clock = systemClock{}
+
+type fixedClock struct {
+	now time.Time
+}
+
+func (c fixedClock) Now() time.Time {
+	return c.now
+}
+
+func NewFixedClock(now time.Time) Clock {
+	return fixedClock{now: now}
+}
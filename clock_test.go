@@ -0,0 +1,13 @@
+package period
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestClock(t *testing.T) {
+	now := time.Date(2024, 8, 14, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, now, NewFixedClock(now).Now())
+	assert.WithinDuration(t, time.Now(), SystemClock.Now(), time.Second)
+}
@@ -0,0 +1,154 @@
+package period
+
+import (
+	"slices"
+	"sort"
+	"time"
+)
+
+// Compare orders periods by start date, included start first, then by end date, excluded end first
+func Compare(a, b Period) int {
+	if c := compareStart(a, b); c != 0 {
+		return c
+	}
+
+	return compareEnd(a, b)
+}
+
+// CompareByEnd orders periods by end date, excluded end first, then by start date
+func CompareByEnd(a, b Period) int {
+	if c := compareEnd(a, b); c != 0 {
+		return c
+	}
+
+	return compareStart(a, b)
+}
+
+// CompareByDuration orders periods by duration, then with Compare
+func CompareByDuration(a, b Period) int {
+	if c := compareDuration(a.GetDateInterval(), b.GetDateInterval()); c != 0 {
+		return c
+	}
+
+	return Compare(a, b)
+}
+
+func compareStart(a, b Period) int {
+	if c := a.startDate.Compare(b.startDate); c != 0 {
+		return c
+	}
+
+	return compareIncluded(boundaryIsStartIncluded(b.GetBoundaryType()), boundaryIsStartIncluded(a.GetBoundaryType()))
+}
+
+func compareEnd(a, b Period) int {
+	if c := a.endDate.Compare(b.endDate); c != 0 {
+		return c
+	}
+
+	return compareIncluded(boundaryIsEndIncluded(a.GetBoundaryType()), boundaryIsEndIncluded(b.GetBoundaryType()))
+}
+
+func compareIncluded(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case b:
+		return -1
+	default:
+		return 1
+	}
+}
+
+func compareDuration(a, b time.Duration) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// SortFunc returns a sorted copy of the sequence, equal periods keep their order
+func (s Sequence) SortFunc(cmp func(a, b Period) int) Sequence {
+	periods := slices.Clone(s.intervals)
+	slices.SortStableFunc(periods, cmp)
+
+	return Sequence{intervals: periods}
+}
+
+// SortedSequence keeps its periods ordered with Compare
+type SortedSequence struct {
+	intervals []Period
+}
+
+func NewSortedSequence(periods ...Period) SortedSequence {
+	return SortedSequence{}.Insert(periods...)
+}
+
+func (s SortedSequence) Insert(periods ...Period) SortedSequence {
+	intervals := slices.Clone(s.intervals)
+
+	for _, period := range periods {
+		index := sort.Search(
+			len(intervals), func(i int) bool {
+				return Compare(intervals[i], period) > 0
+			},
+		)
+		intervals = slices.Insert(intervals, index, period)
+	}
+
+	return SortedSequence{intervals: intervals}
+}
+
+func (s SortedSequence) Count() int {
+	return len(s.intervals)
+}
+
+func (s SortedSequence) Get(index int) Period {
+	if index < 0 || index >= len(s.intervals) {
+		return Period{}
+	}
+
+	return s.intervals[index]
+}
+
+func (s SortedSequence) Sequence() Sequence {
+	return NewSequence(slices.Clone(s.intervals)...)
+}
+
+// Search returns the index of the first period starting at or after date, or Count when there is none
+func (s SortedSequence) Search(date time.Time) int {
+	return sort.Search(
+		len(s.intervals), func(i int) bool {
+			return !s.intervals[i].startDate.Before(date)
+		},
+	)
+}
+
+// Floor returns the last period starting at or before date
+func (s SortedSequence) Floor(date time.Time) (Period, bool) {
+	index := sort.Search(
+		len(s.intervals), func(i int) bool {
+			return s.intervals[i].startDate.After(date)
+		},
+	)
+
+	if index == 0 {
+		return Period{}, false
+	}
+
+	return s.intervals[index-1], true
+}
+
+// Ceiling returns the first period starting at or after date
+func (s SortedSequence) Ceiling(date time.Time) (Period, bool) {
+	index := s.Search(date)
+	if index == len(s.intervals) {
+		return Period{}, false
+	}
+
+	return s.intervals[index], true
+}
@@ -0,0 +1,164 @@
+package period
+
+import (
+	"github.com/stretchr/testify/assert"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name         string
+		a            Period
+		b            Period
+		want         int
+		wantEnd      int
+		wantDuration int
+	}{
+		{
+			name:         "Compare_WithEarlierStart",
+			a:            NewDefaultPeriod(hour(1), hour(5)),
+			b:            NewDefaultPeriod(hour(2), hour(3)),
+			want:         -1,
+			wantEnd:      1,
+			wantDuration: 1,
+		},
+		{
+			name:         "Compare_WithExcludedStart",
+			a:            NewPeriod(hour(1), hour(3), ExcludeStartIncludeEnd),
+			b:            NewDefaultPeriod(hour(1), hour(3)),
+			want:         1,
+			wantEnd:      1,
+			wantDuration: 1,
+		},
+		{
+			name:         "Compare_WithIncludedEnd",
+			a:            NewIncludeAllPeriod(hour(1), hour(3)),
+			b:            NewDefaultPeriod(hour(1), hour(3)),
+			want:         1,
+			wantEnd:      1,
+			wantDuration: 1,
+		},
+		{
+			name:         "Compare_WithEqualPeriods",
+			a:            NewDefaultPeriod(hour(1), hour(3)),
+			b:            NewDefaultPeriod(hour(1), hour(3)),
+			want:         0,
+			wantEnd:      0,
+			wantDuration: 0,
+		},
+		{
+			name:         "Compare_WithShorterDuration",
+			a:            NewDefaultPeriod(hour(5), hour(6)),
+			b:            NewDefaultPeriod(hour(1), hour(3)),
+			want:         1,
+			wantEnd:      1,
+			wantDuration: -1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.want, Compare(tt.a, tt.b))
+				assert.Equal(t, -tt.want, Compare(tt.b, tt.a))
+				assert.Equal(t, tt.wantEnd, CompareByEnd(tt.a, tt.b))
+				assert.Equal(t, tt.wantDuration, CompareByDuration(tt.a, tt.b))
+			},
+		)
+	}
+}
+
+func TestSequenceSortFunc(t *testing.T) {
+	sequence := NewSequence(
+		NewDefaultPeriod(hour(2), hour(8)),
+		NewIncludeAllPeriod(hour(1), hour(3)),
+		NewDefaultPeriod(hour(1), hour(3)),
+	)
+
+	got := sequence.SortFunc(Compare)
+	assert.Equal(t, []Period{sequence.Get(2), sequence.Get(1), sequence.Get(0)}, got.GetInterval())
+
+	got = sequence.SortFunc(CompareByDuration)
+	assert.Equal(t, []Period{sequence.Get(2), sequence.Get(1), sequence.Get(0)}, got.GetInterval())
+
+	assert.True(t, sequence.Get(0).Equals(NewDefaultPeriod(hour(2), hour(8))))
+
+	periods := sequence.GetInterval()
+	slices.SortFunc(periods, CompareByEnd)
+	assert.Equal(t, hour(8), periods[2].GetEndDate())
+}
+
+func TestSortedSequence(t *testing.T) {
+	sequence := NewSortedSequence(
+		NewDefaultPeriod(hour(5), hour(6)),
+		NewDefaultPeriod(hour(1), hour(2)),
+	).Insert(NewDefaultPeriod(hour(3), hour(4)))
+
+	assert.Equal(t, 3, sequence.Count())
+	assert.Equal(t, hour(1), sequence.Get(0).GetStartDate())
+	assert.Equal(t, hour(3), sequence.Get(1).GetStartDate())
+	assert.Equal(t, hour(5), sequence.Get(2).GetStartDate())
+	assert.True(t, sequence.Get(3).IsZero())
+	assert.Equal(t, 3, sequence.Sequence().Count())
+
+	tests := []struct {
+		name        string
+		date        time.Time
+		wantSearch  int
+		wantFloor   time.Time
+		wantFloorOk bool
+		wantCeil    time.Time
+		wantCeilOk  bool
+	}{
+		{
+			name:       "Search_WithDateBeforeAll",
+			date:       hour(0),
+			wantSearch: 0,
+			wantCeil:   hour(1),
+			wantCeilOk: true,
+		},
+		{
+			name:        "Search_WithDateOnStart",
+			date:        hour(3),
+			wantSearch:  1,
+			wantFloor:   hour(3),
+			wantFloorOk: true,
+			wantCeil:    hour(3),
+			wantCeilOk:  true,
+		},
+		{
+			name:        "Search_WithDateBetween",
+			date:        hour(4),
+			wantSearch:  2,
+			wantFloor:   hour(3),
+			wantFloorOk: true,
+			wantCeil:    hour(5),
+			wantCeilOk:  true,
+		},
+		{
+			name:        "Search_WithDateAfterAll",
+			date:        hour(9),
+			wantSearch:  3,
+			wantFloor:   hour(5),
+			wantFloorOk: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.wantSearch, sequence.Search(tt.date))
+
+				floor, ok := sequence.Floor(tt.date)
+				assert.Equal(t, tt.wantFloorOk, ok)
+				assert.Equal(t, tt.wantFloor, floor.GetStartDate())
+
+				ceiling, ok := sequence.Ceiling(tt.date)
+				assert.Equal(t, tt.wantCeilOk, ok)
+				assert.Equal(t, tt.wantCeil, ceiling.GetStartDate())
+			},
+		)
+	}
+}
@@ -0,0 +1,295 @@
+package period
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+var ErrInvalidCSVOptions = errors.New("period: invalid csv options")
+
+type CSVOptions struct {
+	layout         string
+	location       *time.Location
+	startColumn    int
+	endColumn      int
+	boundaryColumn int
+	labelColumn    int
+	header         bool
+	comma          rune
+}
+
+func NewCSVOptions() CSVOptions {
+	return CSVOptions{
+		layout:         time.RFC3339,
+		location:       time.Local,
+		startColumn:    0,
+		endColumn:      1,
+		boundaryColumn: -1,
+		labelColumn:    -1,
+		comma:          ',',
+	}
+}
+
+// WithLayout sets the time layout of the start and end columns, values without zone are read in the location
+func (o CSVOptions) WithLayout(layout string) CSVOptions {
+	o.layout = layout
+
+	return o
+}
+
+func (o CSVOptions) WithLocation(location *time.Location) CSVOptions {
+	if location != nil {
+		o.location = location
+	}
+
+	return o
+}
+
+// WithColumns sets the zero based start and end column indexes
+func (o CSVOptions) WithColumns(start, end int) CSVOptions {
+	o.startColumn = start
+	o.endColumn = end
+
+	return o
+}
+
+// WithBoundaryColumn sets the column holding the boundary type, a negative index means every row is "[)"
+func (o CSVOptions) WithBoundaryColumn(column int) CSVOptions {
+	o.boundaryColumn = column
+
+	return o
+}
+
+func (o CSVOptions) WithLabelColumn(column int) CSVOptions {
+	o.labelColumn = column
+
+	return o
+}
+
+// WithHeader skips the first row when reading and writes a header row when writing
+func (o CSVOptions) WithHeader(header bool) CSVOptions {
+	o.header = header
+
+	return o
+}
+
+func (o CSVOptions) WithComma(comma rune) CSVOptions {
+	o.comma = comma
+
+	return o
+}
+
+func (o CSVOptions) validate() error {
+	if o.startColumn < 0 || o.endColumn < 0 {
+		return fmt.Errorf("%w: negative start or end column %d, %d", ErrInvalidCSVOptions, o.startColumn, o.endColumn)
+	}
+
+	if o.startColumn == o.endColumn {
+		return fmt.Errorf("%w: start and end share column %d", ErrInvalidCSVOptions, o.startColumn)
+	}
+
+	return nil
+}
+
+func (o CSVOptions) width() int {
+	return max(o.startColumn, o.endColumn, o.boundaryColumn, o.labelColumn) + 1
+}
+
+type CSVRecord struct {
+	Line   int
+	Period Period
+	Label  string
+	Fields []string
+}
+
+type CSVError struct {
+	Line   int
+	Column int
+	Err    error
+}
+
+func (e *CSVError) Error() string {
+	if e.Column == 0 {
+		return fmt.Sprintf("period: csv line %d: %v", e.Line, e.Err)
+	}
+
+	return fmt.Sprintf("period: csv line %d, column %d: %v", e.Line, e.Column, e.Err)
+}
+
+func (e *CSVError) Unwrap() error {
+	return e.Err
+}
+
+type CSVResult struct {
+	Records []CSVRecord
+	Errors  []*CSVError
+}
+
+func (r CSVResult) Sequence() Sequence {
+	periods := make([]Period, 0, len(r.Records))
+
+	for _, record := range r.Records {
+		periods = append(periods, record.Period)
+	}
+
+	return NewSequence(periods...)
+}
+
+// ReadCSV collects the invalid rows in CSVResult.Errors and keeps reading, the error is only set when the options are invalid or reading fails
+func ReadCSV(r io.Reader, options CSVOptions) (CSVResult, error) {
+	if err := options.validate(); err != nil {
+		return CSVResult{}, err
+	}
+
+	reader := csv.NewReader(r)
+	reader.Comma = options.comma
+	reader.FieldsPerRecord = -1
+
+	result := CSVResult{}
+	first := true
+
+	for {
+		fields, err := reader.Read()
+		if err == io.EOF {
+			return result, nil
+		}
+
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			result.Errors = append(result.Errors, &CSVError{Line: parseErr.Line, Column: parseErr.Column, Err: parseErr.Err})
+			first = false
+			continue
+		}
+
+		if err != nil {
+			return result, err
+		}
+
+		line, _ := reader.FieldPos(0)
+
+		if first && options.header {
+			first = false
+			continue
+		}
+		first = false
+
+		record, csvErr := options.parseRecord(line, fields)
+		if csvErr != nil {
+			result.Errors = append(result.Errors, csvErr)
+			continue
+		}
+
+		result.Records = append(result.Records, record)
+	}
+}
+
+func (o CSVOptions) parseRecord(line int, fields []string) (CSVRecord, *CSVError) {
+	if len(fields) < o.width() {
+		return CSVRecord{}, &CSVError{
+			Line: line,
+			Err:  fmt.Errorf("expected at least %d fields, got %d", o.width(), len(fields)),
+		}
+	}
+
+	startDate, err := time.ParseInLocation(o.layout, strings.TrimSpace(fields[o.startColumn]), o.location)
+	if err != nil {
+		return CSVRecord{}, &CSVError{Line: line, Column: o.startColumn + 1, Err: err}
+	}
+
+	endDate, err := time.ParseInLocation(o.layout, strings.TrimSpace(fields[o.endColumn]), o.location)
+	if err != nil {
+		return CSVRecord{}, &CSVError{Line: line, Column: o.endColumn + 1, Err: err}
+	}
+
+	boundaryType := IncludeStartExcludeEnd
+	if o.boundaryColumn >= 0 {
+		if value := strings.TrimSpace(fields[o.boundaryColumn]); value != "" {
+			if _, ok := boundaryTypes[value]; !ok {
+				return CSVRecord{}, &CSVError{
+					Line:   line,
+					Column: o.boundaryColumn + 1,
+					Err:    fmt.Errorf("invalid boundary type %q", value),
+				}
+			}
+			boundaryType = value
+		}
+	}
+
+	record := CSVRecord{
+		Line:   line,
+		Period: NewPeriod(startDate, endDate, boundaryType),
+		Fields: fields,
+	}
+
+	if o.labelColumn >= 0 {
+		record.Label = fields[o.labelColumn]
+	}
+
+	return record, nil
+}
+
+func WriteCSV(w io.Writer, sequence Sequence, options CSVOptions) error {
+	records := make([]CSVRecord, 0, sequence.Count())
+
+	for _, period := range sequence.intervals {
+		records = append(records, CSVRecord{Period: period})
+	}
+
+	return WriteCSVRecords(w, records, options)
+}
+
+// WriteCSVRecords writes the records on top of their original fields, so extra columns survive a read/write round trip
+func WriteCSVRecords(w io.Writer, records []CSVRecord, options CSVOptions) error {
+	if err := options.validate(); err != nil {
+		return err
+	}
+
+	writer := csv.NewWriter(w)
+	writer.Comma = options.comma
+
+	if options.header {
+		header := make([]string, options.width())
+		header[options.startColumn] = "start"
+		header[options.endColumn] = "end"
+
+		if options.boundaryColumn >= 0 {
+			header[options.boundaryColumn] = "boundary"
+		}
+
+		if options.labelColumn >= 0 {
+			header[options.labelColumn] = "label"
+		}
+
+		if err := writer.Write(header); err != nil {
+			return err
+		}
+	}
+
+	for _, record := range records {
+		fields := make([]string, max(options.width(), len(record.Fields)))
+		copy(fields, record.Fields)
+
+		fields[options.startColumn] = record.Period.startDate.In(options.location).Format(options.layout)
+		fields[options.endColumn] = record.Period.endDate.In(options.location).Format(options.layout)
+
+		if options.boundaryColumn >= 0 {
+			fields[options.boundaryColumn] = record.Period.GetBoundaryType()
+		}
+
+		if options.labelColumn >= 0 {
+			fields[options.labelColumn] = record.Label
+		}
+
+		if err := writer.Write(fields); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+
+	return writer.Error()
+}
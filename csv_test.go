@@ -0,0 +1,222 @@
+package period
+
+import (
+	"bytes"
+	"encoding/csv"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReadCSV(t *testing.T) {
+	shanghai := time.FixedZone("CST", 8*3600)
+
+	tests := []struct {
+		name        string
+		data        string
+		options     CSVOptions
+		wantRecords []CSVRecord
+		wantErrors  []CSVError
+	}{
+		{
+			name:    "ReadCSV_WithDefaultOptions",
+			data:    "2023-01-01T00:00:00Z,2023-01-02T00:00:00Z\n2023-01-05T00:00:00Z,2023-01-03T00:00:00Z\n",
+			options: NewCSVOptions(),
+			wantRecords: []CSVRecord{
+				{
+					Line:   1,
+					Period: NewDefaultPeriod(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)),
+				},
+				{
+					Line:   2,
+					Period: NewDefaultPeriod(time.Date(2023, 1, 3, 0, 0, 0, 0, time.UTC), time.Date(2023, 1, 5, 0, 0, 0, 0, time.UTC)),
+				},
+			},
+		},
+		{
+			name: "ReadCSV_WithCustomColumnsAndLayout",
+			data: "label;boundary;end;start\n" +
+				"db upgrade;[];2023-01-01 12:00;2023-01-01 10:00\n" +
+				"network;;2023-01-02 12:00;2023-01-02 10:00\n",
+			options: NewCSVOptions().
+				WithComma(';').
+				WithHeader(true).
+				WithLayout("2006-01-02 15:04").
+				WithLocation(shanghai).
+				WithColumns(3, 2).
+				WithBoundaryColumn(1).
+				WithLabelColumn(0),
+			wantRecords: []CSVRecord{
+				{
+					Line:   2,
+					Label:  "db upgrade",
+					Period: NewIncludeAllPeriod(time.Date(2023, 1, 1, 10, 0, 0, 0, shanghai), time.Date(2023, 1, 1, 12, 0, 0, 0, shanghai)),
+				},
+				{
+					Line:   3,
+					Label:  "network",
+					Period: NewDefaultPeriod(time.Date(2023, 1, 2, 10, 0, 0, 0, shanghai), time.Date(2023, 1, 2, 12, 0, 0, 0, shanghai)),
+				},
+			},
+		},
+		{
+			name: "ReadCSV_WithInvalidRows",
+			data: "2023-01-01T00:00:00Z,2023-01-02T00:00:00Z,[)\n" +
+				"yesterday,2023-01-02T00:00:00Z,[)\n" +
+				"2023-01-01T00:00:00Z,tomorrow,[)\n" +
+				"2023-01-01T00:00:00Z,2023-01-02T00:00:00Z,<>\n" +
+				"2023-01-01T00:00:00Z\n" +
+				"2023-01-01T00:00:00Z,\"2023-01-02\"T00:00:00Z,[)\n" +
+				"2023-01-03T00:00:00Z,2023-01-04T00:00:00Z,(]\n",
+			options: NewCSVOptions().WithBoundaryColumn(2),
+			wantRecords: []CSVRecord{
+				{
+					Line:   1,
+					Period: NewDefaultPeriod(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)),
+				},
+				{
+					Line:   7,
+					Period: NewPeriod(time.Date(2023, 1, 3, 0, 0, 0, 0, time.UTC), time.Date(2023, 1, 4, 0, 0, 0, 0, time.UTC), ExcludeStartIncludeEnd),
+				},
+			},
+			wantErrors: []CSVError{
+				{Line: 2, Column: 1},
+				{Line: 3, Column: 2},
+				{Line: 4, Column: 3},
+				{Line: 5, Column: 0},
+				{Line: 6, Column: 33},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, err := ReadCSV(strings.NewReader(tt.data), tt.options)
+				assert.NoError(t, err)
+
+				assert.Equal(t, len(tt.wantRecords), len(got.Records))
+				for i, want := range tt.wantRecords {
+					assert.Equal(t, want.Line, got.Records[i].Line)
+					assert.Equal(t, want.Label, got.Records[i].Label)
+					assert.True(t, want.Period.Equals(got.Records[i].Period), "%s != %s", want.Period.Format(time.RFC3339), got.Records[i].Period.Format(time.RFC3339))
+				}
+
+				assert.Equal(t, len(tt.wantErrors), len(got.Errors))
+				for i, want := range tt.wantErrors {
+					assert.Equal(t, want.Line, got.Errors[i].Line)
+					assert.Equal(t, want.Column, got.Errors[i].Column)
+					assert.Error(t, got.Errors[i].Err)
+				}
+			},
+		)
+	}
+}
+
+func TestCSVInvalidColumns(t *testing.T) {
+	tests := []struct {
+		name    string
+		options CSVOptions
+	}{
+		{
+			name:    "InvalidColumns_WithNegativeStart",
+			options: NewCSVOptions().WithColumns(-1, 1),
+		},
+		{
+			name:    "InvalidColumns_WithNegativeEnd",
+			options: NewCSVOptions().WithColumns(0, -2),
+		},
+		{
+			name:    "InvalidColumns_WithSharedColumn",
+			options: NewCSVOptions().WithColumns(1, 1),
+		},
+	}
+
+	sequence := NewSequence(NewDefaultPeriod(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)))
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				_, err := ReadCSV(strings.NewReader("2023-01-01T00:00:00Z,2023-01-02T00:00:00Z\n"), tt.options)
+				assert.ErrorIs(t, err, ErrInvalidCSVOptions)
+
+				var buffer bytes.Buffer
+				assert.ErrorIs(t, WriteCSV(&buffer, sequence, tt.options.WithHeader(true)), ErrInvalidCSVOptions)
+				assert.Empty(t, buffer.String())
+			},
+		)
+	}
+}
+
+func TestCSVError(t *testing.T) {
+	err := &CSVError{Line: 3, Column: 2, Err: csv.ErrQuote}
+
+	assert.Equal(t, `period: csv line 3, column 2: extraneous or missing " in quoted-field`, err.Error())
+	assert.ErrorIs(t, err, csv.ErrQuote)
+	assert.Equal(t, "period: csv line 3: EOF", (&CSVError{Line: 3, Err: io.EOF}).Error())
+}
+
+func TestCSVResultSequence(t *testing.T) {
+	period := NewDefaultPeriod(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC))
+	result := CSVResult{Records: []CSVRecord{{Period: period}}}
+
+	assert.True(t, NewSequence(period).Equals(result.Sequence()))
+	assert.True(t, NewSequence().Equals(CSVResult{}.Sequence()))
+}
+
+func TestWriteCSV(t *testing.T) {
+	sequence := NewSequence(
+		NewDefaultPeriod(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)),
+		NewIncludeAllPeriod(time.Date(2023, 1, 3, 0, 0, 0, 0, time.UTC), time.Date(2023, 1, 4, 0, 0, 0, 0, time.UTC)),
+	)
+
+	tests := []struct {
+		name    string
+		options CSVOptions
+		want    string
+	}{
+		{
+			name:    "WriteCSV_WithDefaultOptions",
+			options: NewCSVOptions().WithLocation(time.UTC),
+			want:    "2023-01-01T00:00:00Z,2023-01-02T00:00:00Z\n2023-01-03T00:00:00Z,2023-01-04T00:00:00Z\n",
+		},
+		{
+			name: "WriteCSV_WithHeaderAndBoundary",
+			options: NewCSVOptions().
+				WithLocation(time.UTC).
+				WithLayout(time.DateOnly).
+				WithHeader(true).
+				WithComma(';').
+				WithColumns(1, 2).
+				WithBoundaryColumn(0),
+			want: "boundary;start;end\n[);2023-01-01;2023-01-02\n[];2023-01-03;2023-01-04\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				var buffer bytes.Buffer
+				assert.NoError(t, WriteCSV(&buffer, sequence, tt.options))
+				assert.Equal(t, tt.want, buffer.String())
+			},
+		)
+	}
+}
+
+func TestCSVRoundTrip(t *testing.T) {
+	data := "start,end,boundary,label,owner\n" +
+		"2023-01-01T00:00:00Z,2023-01-02T00:00:00Z,(],outage,ops\n"
+
+	options := NewCSVOptions().WithLocation(time.UTC).WithHeader(true).WithBoundaryColumn(2).WithLabelColumn(3)
+
+	result, err := ReadCSV(strings.NewReader(data), options)
+	assert.NoError(t, err)
+	assert.Empty(t, result.Errors)
+
+	var buffer bytes.Buffer
+	assert.NoError(t, WriteCSVRecords(&buffer, result.Records, options))
+	assert.Equal(t, "start,end,boundary,label\n2023-01-01T00:00:00Z,2023-01-02T00:00:00Z,(],outage,ops\n", buffer.String())
+}
@@ -0,0 +1,56 @@
+package period
+
+import (
+	"math"
+	"math/big"
+	"time"
+)
+
+// duration modes 时长计算方式
+const (
+	DurationSum   = "sum"
+	DurationUnion = "union"
+)
+
+var (
+	maxDuration = big.NewInt(math.MaxInt64)
+	minDuration = big.NewInt(math.MinInt64)
+)
+
+// TotalDuration sums the durations at nanosecond precision, DurationUnion counts overlapping parts once,
+// totals beyond the time.Duration range saturate
+func (s Sequence) TotalDuration(mode string) time.Duration {
+	total := s.TotalNanoseconds(mode)
+
+	switch {
+	case total.Cmp(maxDuration) > 0:
+		return time.Duration(math.MaxInt64)
+	case total.Cmp(minDuration) < 0:
+		return time.Duration(math.MinInt64)
+	default:
+		return time.Duration(total.Int64())
+	}
+}
+
+// TotalNanoseconds returns the exact total of TotalDuration, without any range limit
+func (s Sequence) TotalNanoseconds(mode string) *big.Int {
+	periods := s.intervals
+	if mode == DurationUnion {
+		periods = s.normalize().intervals
+	}
+
+	total := new(big.Int)
+	for _, period := range periods {
+		total.Add(total, period.nanoseconds())
+	}
+
+	return total
+}
+
+// nanoseconds returns the exact elapsed time of the period, unlike GetDateInterval it does not saturate
+func (p Period) nanoseconds() *big.Int {
+	seconds := big.NewInt(p.endDate.Unix() - p.startDate.Unix())
+	seconds.Mul(seconds, big.NewInt(int64(time.Second)))
+
+	return seconds.Add(seconds, big.NewInt(int64(p.endDate.Nanosecond()-p.startDate.Nanosecond())))
+}
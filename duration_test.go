@@ -0,0 +1,80 @@
+package period
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestSequenceTotalDuration(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		sequence  Sequence
+		mode      string
+		want      time.Duration
+		wantExact *big.Int
+	}{
+		{
+			name: "TotalDuration_WithSubSecondPrecision",
+			sequence: NewSequence(
+				NewDefaultPeriod(start, start.Add(1500*time.Millisecond)),
+				NewDefaultPeriod(start.Add(time.Hour+300*time.Millisecond), start.Add(time.Hour+900*time.Millisecond)),
+			),
+			mode:      DurationSum,
+			want:      2100 * time.Millisecond,
+			wantExact: big.NewInt(int64(2100 * time.Millisecond)),
+		},
+		{
+			name: "TotalDuration_WithOverlapsSummed",
+			sequence: NewSequence(
+				NewDefaultPeriod(start, start.Add(2*time.Hour)),
+				NewDefaultPeriod(start.Add(time.Hour), start.Add(3*time.Hour)),
+			),
+			mode:      DurationSum,
+			want:      4 * time.Hour,
+			wantExact: big.NewInt(int64(4 * time.Hour)),
+		},
+		{
+			name: "TotalDuration_WithOverlapsUnited",
+			sequence: NewSequence(
+				NewDefaultPeriod(start, start.Add(2*time.Hour)),
+				NewDefaultPeriod(start.Add(time.Hour), start.Add(3*time.Hour)),
+			),
+			mode:      DurationUnion,
+			want:      3 * time.Hour,
+			wantExact: big.NewInt(int64(3 * time.Hour)),
+		},
+		{
+			name: "TotalDuration_WithSaturation",
+			sequence: NewSequence(
+				NewDefaultPeriod(time.Date(1700, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)),
+			),
+			mode: DurationSum,
+			want: time.Duration(math.MaxInt64),
+			wantExact: new(big.Int).Mul(
+				big.NewInt(time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC).Unix()-time.Date(1700, 1, 1, 0, 0, 0, 0, time.UTC).Unix()),
+				big.NewInt(int64(time.Second)),
+			),
+		},
+		{
+			name:      "TotalDuration_WithEmptySequence",
+			sequence:  NewSequence(),
+			mode:      DurationUnion,
+			want:      0,
+			wantExact: big.NewInt(0),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.want, tt.sequence.TotalDuration(tt.mode))
+				assert.Equal(t, 0, tt.wantExact.Cmp(tt.sequence.TotalNanoseconds(tt.mode)))
+			},
+		)
+	}
+}
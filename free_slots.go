@@ -0,0 +1,109 @@
+package period
+
+import (
+	"time"
+)
+
+type SlotFinder struct {
+	window       Period
+	duration     time.Duration
+	buffer       time.Duration
+	alignment    time.Duration
+	limit        int
+	workingHours []Sequence
+}
+
+func NewSlotFinder(window Period, duration time.Duration) SlotFinder {
+	return SlotFinder{
+		window:   window,
+		duration: duration,
+	}
+}
+
+func FindFreeSlots(window Period, duration time.Duration, busy ...Sequence) Sequence {
+	return NewSlotFinder(window, duration).Find(busy...)
+}
+
+// WithBuffer keeps the given time free before and after every busy period
+func (f SlotFinder) WithBuffer(buffer time.Duration) SlotFinder {
+	f.buffer = buffer
+
+	return f
+}
+
+// WithAlignment makes slots start on a multiple of alignment counted from midnight, e.g. 30 minutes for :00/:30
+func (f SlotFinder) WithAlignment(alignment time.Duration) SlotFinder {
+	f.alignment = alignment
+
+	return f
+}
+
+// WithLimit caps the number of returned slots, zero or less means no limit
+func (f SlotFinder) WithLimit(limit int) SlotFinder {
+	f.limit = limit
+
+	return f
+}
+
+// WithWorkingHours restricts the slots to the time covered by every given sequence, one per attendee
+func (f SlotFinder) WithWorkingHours(workingHours ...Sequence) SlotFinder {
+	f.workingHours = append(append([]Sequence{}, f.workingHours...), workingHours...)
+
+	return f
+}
+
+func (f SlotFinder) available() Sequence {
+	available := NewSequence(f.window)
+
+	for _, hours := range f.workingHours {
+		available = available.Intersect(hours)
+	}
+
+	return available
+}
+
+func (f SlotFinder) busy(busy ...Sequence) Sequence {
+	sequence := Sequence{}
+
+	for _, calendar := range busy {
+		for _, period := range calendar.intervals {
+			sequence = sequence.Push(period.Expand(f.buffer))
+		}
+	}
+
+	return sequence.Unions()
+}
+
+func (f SlotFinder) align(period Period) Period {
+	if f.alignment <= 0 {
+		return period
+	}
+
+	startDate := ceilTime(period.startDate, f.alignment)
+	if startDate.Equal(period.startDate) {
+		return period
+	}
+
+	return period.StartingOn(startDate).BoundedBy(boundaryIncludeStart(period.boundaryType))
+}
+
+func (f SlotFinder) Find(busy ...Sequence) Sequence {
+	free := f.available().Subtract(f.busy(busy...))
+	free = free.Sorted(free.sortByStartDate)
+	slots := Sequence{}
+
+	for _, period := range free.intervals {
+		slot := f.align(period)
+		if slot.startDate.After(slot.endDate) || slot.GetDateInterval() < f.duration {
+			continue
+		}
+
+		slots = slots.Push(slot)
+
+		if f.limit > 0 && slots.Count() >= f.limit {
+			break
+		}
+	}
+
+	return slots
+}
@@ -0,0 +1,132 @@
+package period
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestFindFreeSlots(t *testing.T) {
+	day := func(hour, minute int) time.Time {
+		return time.Date(2023, 1, 2, hour, minute, 0, 0, time.UTC)
+	}
+
+	window := NewDefaultPeriod(day(9, 0), day(18, 0))
+
+	alice := NewSequence(
+		NewDefaultPeriod(day(9, 0), day(10, 0)),
+		NewDefaultPeriod(day(13, 0), day(14, 0)),
+	)
+	bob := NewSequence(
+		NewDefaultPeriod(day(9, 30), day(11, 10)),
+		NewDefaultPeriod(day(15, 0), day(15, 20)),
+	)
+
+	tests := []struct {
+		name   string
+		finder SlotFinder
+		busy   []Sequence
+		want   []Period
+	}{
+		{
+			name:   "FindFreeSlots_WithoutBusy",
+			finder: NewSlotFinder(window, time.Hour),
+			busy:   nil,
+			want:   []Period{window},
+		},
+		{
+			name:   "FindFreeSlots_WithSeveralCalendars",
+			finder: NewSlotFinder(window, 30*time.Minute),
+			busy:   []Sequence{alice, bob},
+			want: []Period{
+				NewDefaultPeriod(day(11, 10), day(13, 0)),
+				NewDefaultPeriod(day(14, 0), day(15, 0)),
+				NewDefaultPeriod(day(15, 20), day(18, 0)),
+			},
+		},
+		{
+			name:   "FindFreeSlots_WithMinimumDuration",
+			finder: NewSlotFinder(window, 2*time.Hour),
+			busy:   []Sequence{alice, bob},
+			want: []Period{
+				NewDefaultPeriod(day(15, 20), day(18, 0)),
+			},
+		},
+		{
+			name:   "FindFreeSlots_WithBuffer",
+			finder: NewSlotFinder(window, 30*time.Minute).WithBuffer(10 * time.Minute),
+			busy:   []Sequence{alice, bob},
+			want: []Period{
+				NewDefaultPeriod(day(11, 20), day(12, 50)),
+				NewDefaultPeriod(day(14, 10), day(14, 50)),
+				NewDefaultPeriod(day(15, 30), day(18, 0)),
+			},
+		},
+		{
+			name:   "FindFreeSlots_WithAlignment",
+			finder: NewSlotFinder(window, time.Hour).WithAlignment(30 * time.Minute),
+			busy:   []Sequence{alice, bob},
+			want: []Period{
+				NewDefaultPeriod(day(11, 30), day(13, 0)),
+				NewDefaultPeriod(day(14, 0), day(15, 0)),
+				NewDefaultPeriod(day(15, 30), day(18, 0)),
+			},
+		},
+		{
+			name:   "FindFreeSlots_WithLimit",
+			finder: NewSlotFinder(window, 30*time.Minute).WithLimit(2),
+			busy:   []Sequence{alice, bob},
+			want: []Period{
+				NewDefaultPeriod(day(11, 10), day(13, 0)),
+				NewDefaultPeriod(day(14, 0), day(15, 0)),
+			},
+		},
+		{
+			name: "FindFreeSlots_WithWorkingHours",
+			finder: NewSlotFinder(window, 30*time.Minute).WithWorkingHours(
+				NewSequence(NewDefaultPeriod(day(8, 0), day(16, 0))),
+				NewSequence(NewDefaultPeriod(day(10, 0), day(12, 0)), NewDefaultPeriod(day(14, 0), day(17, 0))),
+			),
+			busy: []Sequence{alice, bob},
+			want: []Period{
+				NewDefaultPeriod(day(11, 10), day(12, 0)),
+				NewDefaultPeriod(day(14, 0), day(15, 0)),
+				NewDefaultPeriod(day(15, 20), day(16, 0)),
+			},
+		},
+		{
+			name:   "FindFreeSlots_WithFullyBusyWindow",
+			finder: NewSlotFinder(window, 30*time.Minute),
+			busy:   []Sequence{NewSequence(NewDefaultPeriod(day(8, 0), day(19, 0)))},
+			want:   []Period{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got := tt.finder.Find(tt.busy...)
+				assert.Equal(t, len(tt.want), got.Count())
+				for i, want := range tt.want {
+					assert.True(t, want.Equals(got.Get(i)), "%s != %s", want.Format(time.DateTime), got.Get(i).Format(time.DateTime))
+				}
+			},
+		)
+	}
+}
+
+func TestFindFreeSlotsShortcut(t *testing.T) {
+	window := NewDefaultPeriod(time.Date(2023, 1, 2, 9, 0, 0, 0, time.UTC), time.Date(2023, 1, 2, 12, 0, 0, 0, time.UTC))
+	busy := NewSequence(NewDefaultPeriod(time.Date(2023, 1, 2, 10, 0, 0, 0, time.UTC), time.Date(2023, 1, 2, 11, 0, 0, 0, time.UTC)))
+
+	got := FindFreeSlots(window, time.Hour, busy)
+
+	assert.True(
+		t, got.Equals(
+			NewSequence(
+				NewDefaultPeriod(time.Date(2023, 1, 2, 9, 0, 0, 0, time.UTC), time.Date(2023, 1, 2, 10, 0, 0, 0, time.UTC)),
+				NewDefaultPeriod(time.Date(2023, 1, 2, 11, 0, 0, 0, time.UTC), time.Date(2023, 1, 2, 12, 0, 0, 0, time.UTC)),
+			),
+		),
+	)
+}
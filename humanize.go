@@ -0,0 +1,210 @@
+package period
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Locale formats the humanized parts of periods, dates are given in their own location
+type Locale interface {
+	// DateRange formats whole days from start to last, both included
+	DateRange(start, last time.Time) string
+	TimeRange(start, end time.Time) string
+	Duration(duration time.Duration) string
+	List(items []string) string
+}
+
+var (
+	English           Locale = englishLocale{}
+	SimplifiedChinese Locale = chineseLocale{}
+)
+
+type durationUnit struct {
+	duration time.Duration
+	english  string
+	chinese  string
+}
+
+var durationUnits = []durationUnit{
+	{duration: 24 * time.Hour, english: "day", chinese: "天"},
+	{duration: time.Hour, english: "hour", chinese: "小时"},
+	{duration: time.Minute, english: "minute", chinese: "分钟"},
+	{duration: time.Second, english: "second", chinese: "秒"},
+}
+
+// Humanize formats the period collapsing the shared components, periods made of whole days are printed as dates
+func (p Period) Humanize(locale Locale) string {
+	if locale == nil {
+		locale = English
+	}
+
+	if p.isWholeDays() {
+		return locale.DateRange(p.startDate, p.endDate.AddDate(0, 0, -1))
+	}
+
+	return locale.TimeRange(p.startDate, p.endDate)
+}
+
+func (p Period) HumanizeDuration(locale Locale) string {
+	if locale == nil {
+		locale = English
+	}
+
+	return locale.Duration(p.GetDateInterval())
+}
+
+func (s Sequence) Humanize(locale Locale) string {
+	if locale == nil {
+		locale = English
+	}
+
+	items := make([]string, 0, len(s.intervals))
+	for _, period := range s.intervals {
+		items = append(items, period.Humanize(locale))
+	}
+
+	return locale.List(items)
+}
+
+func (p Period) isWholeDays() bool {
+	return p.startDate.Before(p.endDate) &&
+		isMidnight(p.startDate) &&
+		isMidnight(p.endDate) &&
+		!boundaryIsEndIncluded(p.GetBoundaryType())
+}
+
+func isMidnight(t time.Time) bool {
+	return t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0 && t.Nanosecond() == 0
+}
+
+func sameDay(t, other time.Time) bool {
+	return t.Year() == other.Year() && t.YearDay() == other.YearDay()
+}
+
+// splitDuration returns the largest unit of the duration followed by the next one when it is not zero, e.g. 2 days 3 hours
+func splitDuration(duration time.Duration) ([]int, []durationUnit) {
+	var (
+		counts []int
+		units  []durationUnit
+	)
+
+	for _, unit := range durationUnits {
+		count := int(duration / unit.duration)
+		if count == 0 {
+			if len(counts) > 0 {
+				break
+			}
+			continue
+		}
+
+		counts = append(counts, count)
+		units = append(units, unit)
+		duration -= time.Duration(count) * unit.duration
+
+		if len(counts) == 2 {
+			break
+		}
+	}
+
+	return counts, units
+}
+
+type englishLocale struct{}
+
+func (englishLocale) DateRange(start, last time.Time) string {
+	switch {
+	case sameDay(start, last):
+		return start.Format("Jan 2, 2006")
+	case start.Year() == last.Year() && start.Month() == last.Month():
+		return fmt.Sprintf("%s–%d, %d", start.Format("Jan 2"), last.Day(), last.Year())
+	case start.Year() == last.Year():
+		return fmt.Sprintf("%s – %s", start.Format("Jan 2"), last.Format("Jan 2, 2006"))
+	default:
+		return fmt.Sprintf("%s – %s", start.Format("Jan 2, 2006"), last.Format("Jan 2, 2006"))
+	}
+}
+
+func (englishLocale) TimeRange(start, end time.Time) string {
+	switch {
+	case sameDay(start, end):
+		return fmt.Sprintf("%s–%s on %s", start.Format("15:04"), end.Format("15:04"), start.Format("Jan 2, 2006"))
+	case start.Year() == end.Year():
+		return fmt.Sprintf("%s – %s", start.Format("Jan 2 15:04"), end.Format("Jan 2 15:04, 2006"))
+	default:
+		return fmt.Sprintf("%s – %s", start.Format("Jan 2, 2006 15:04"), end.Format("Jan 2, 2006 15:04"))
+	}
+}
+
+func (englishLocale) Duration(duration time.Duration) string {
+	counts, units := splitDuration(duration)
+	if len(counts) == 0 {
+		return "0 seconds"
+	}
+
+	parts := make([]string, len(counts))
+	for i, count := range counts {
+		parts[i] = fmt.Sprintf("%d %s", count, units[i].english)
+		if count > 1 {
+			parts[i] += "s"
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
+
+func (englishLocale) List(items []string) string {
+	if len(items) < 2 {
+		return strings.Join(items, "")
+	}
+
+	return strings.Join(items[:len(items)-1], ", ") + " and " + items[len(items)-1]
+}
+
+type chineseLocale struct{}
+
+func (chineseLocale) DateRange(start, last time.Time) string {
+	switch {
+	case sameDay(start, last):
+		return start.Format("2006年1月2日")
+	case start.Year() == last.Year() && start.Month() == last.Month():
+		return fmt.Sprintf("%s至%d日", start.Format("2006年1月2日"), last.Day())
+	case start.Year() == last.Year():
+		return fmt.Sprintf("%s至%s", start.Format("2006年1月2日"), last.Format("1月2日"))
+	default:
+		return fmt.Sprintf("%s至%s", start.Format("2006年1月2日"), last.Format("2006年1月2日"))
+	}
+}
+
+func (chineseLocale) TimeRange(start, end time.Time) string {
+	switch {
+	case sameDay(start, end):
+		return fmt.Sprintf("%s至%s", start.Format("2006年1月2日 15:04"), end.Format("15:04"))
+	case start.Year() == end.Year():
+		return fmt.Sprintf("%s至%s", start.Format("2006年1月2日 15:04"), end.Format("1月2日 15:04"))
+	default:
+		return fmt.Sprintf("%s至%s", start.Format("2006年1月2日 15:04"), end.Format("2006年1月2日 15:04"))
+	}
+}
+
+func (chineseLocale) Duration(duration time.Duration) string {
+	counts, units := splitDuration(duration)
+	if len(counts) == 0 {
+		return "0秒"
+	}
+
+	var builder strings.Builder
+	for i, count := range counts {
+		builder.WriteString(fmt.Sprintf("%d%s", count, units[i].chinese))
+	}
+
+	return builder.String()
+}
+
+func (chineseLocale) List(items []string) string {
+	if len(items) < 2 {
+		return strings.Join(items, "")
+	}
+
+	return strings.Join(items[:len(items)-1], "、") + "和" + items[len(items)-1]
+}
@@ -0,0 +1,170 @@
+package period
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestPeriodHumanize(t *testing.T) {
+	date := func(year, month, day, hour, minute int) time.Time {
+		return time.Date(year, time.Month(month), day, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name        string
+		period      Period
+		wantEnglish string
+		wantChinese string
+	}{
+		{
+			name:        "Humanize_WithSingleDay",
+			period:      NewDefaultPeriod(date(2023, 1, 1, 0, 0), date(2023, 1, 2, 0, 0)),
+			wantEnglish: "Jan 1, 2023",
+			wantChinese: "2023年1月1日",
+		},
+		{
+			name:        "Humanize_WithDaysInSameMonth",
+			period:      NewDefaultPeriod(date(2023, 1, 1, 0, 0), date(2023, 1, 4, 0, 0)),
+			wantEnglish: "Jan 1–3, 2023",
+			wantChinese: "2023年1月1日至3日",
+		},
+		{
+			name:        "Humanize_WithDaysInSameYear",
+			period:      NewDefaultPeriod(date(2023, 1, 30, 0, 0), date(2023, 2, 3, 0, 0)),
+			wantEnglish: "Jan 30 – Feb 2, 2023",
+			wantChinese: "2023年1月30日至2月2日",
+		},
+		{
+			name:        "Humanize_WithDaysAcrossYears",
+			period:      NewDefaultPeriod(date(2022, 12, 30, 0, 0), date(2023, 1, 3, 0, 0)),
+			wantEnglish: "Dec 30, 2022 – Jan 2, 2023",
+			wantChinese: "2022年12月30日至2023年1月2日",
+		},
+		{
+			name:        "Humanize_WithTimesInSameDay",
+			period:      NewDefaultPeriod(date(2023, 3, 5, 10, 0), date(2023, 3, 5, 11, 30)),
+			wantEnglish: "10:00–11:30 on Mar 5, 2023",
+			wantChinese: "2023年3月5日 10:00至11:30",
+		},
+		{
+			name:        "Humanize_WithTimesInSameYear",
+			period:      NewDefaultPeriod(date(2023, 3, 5, 22, 0), date(2023, 3, 6, 1, 0)),
+			wantEnglish: "Mar 5 22:00 – Mar 6 01:00, 2023",
+			wantChinese: "2023年3月5日 22:00至3月6日 01:00",
+		},
+		{
+			name:        "Humanize_WithTimesAcrossYears",
+			period:      NewDefaultPeriod(date(2022, 12, 31, 22, 0), date(2023, 1, 1, 1, 0)),
+			wantEnglish: "Dec 31, 2022 22:00 – Jan 1, 2023 01:00",
+			wantChinese: "2022年12月31日 22:00至2023年1月1日 01:00",
+		},
+		{
+			name:        "Humanize_WithIncludedMidnightEnd",
+			period:      NewIncludeAllPeriod(date(2023, 1, 1, 0, 0), date(2023, 1, 2, 0, 0)),
+			wantEnglish: "Jan 1 00:00 – Jan 2 00:00, 2023",
+			wantChinese: "2023年1月1日 00:00至1月2日 00:00",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.wantEnglish, tt.period.Humanize(English))
+				assert.Equal(t, tt.wantEnglish, tt.period.Humanize(nil))
+				assert.Equal(t, tt.wantChinese, tt.period.Humanize(SimplifiedChinese))
+			},
+		)
+	}
+}
+
+func TestPeriodHumanizeDuration(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		duration    time.Duration
+		wantEnglish string
+		wantChinese string
+	}{
+		{
+			name:        "HumanizeDuration_WithDaysAndHours",
+			duration:    51*time.Hour + 20*time.Minute,
+			wantEnglish: "2 days 3 hours",
+			wantChinese: "2天3小时",
+		},
+		{
+			name:        "HumanizeDuration_WithSingularUnit",
+			duration:    time.Hour + time.Minute,
+			wantEnglish: "1 hour 1 minute",
+			wantChinese: "1小时1分钟",
+		},
+		{
+			name:        "HumanizeDuration_WithSkippedUnit",
+			duration:    24*time.Hour + 5*time.Minute,
+			wantEnglish: "1 day",
+			wantChinese: "1天",
+		},
+		{
+			name:        "HumanizeDuration_WithZeroDuration",
+			duration:    0,
+			wantEnglish: "0 seconds",
+			wantChinese: "0秒",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				period := NewDefaultPeriod(start, start.Add(tt.duration))
+				assert.Equal(t, tt.wantEnglish, period.HumanizeDuration(nil))
+				assert.Equal(t, tt.wantChinese, period.HumanizeDuration(SimplifiedChinese))
+			},
+		)
+	}
+}
+
+func TestSequenceHumanize(t *testing.T) {
+	day := func(d int) time.Time {
+		return time.Date(2023, 1, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name        string
+		sequence    Sequence
+		wantEnglish string
+		wantChinese string
+	}{
+		{
+			name: "Humanize_WithSeveralPeriods",
+			sequence: NewSequence(
+				NewDefaultPeriod(day(1), day(2)),
+				NewDefaultPeriod(day(3), day(5)),
+				NewDefaultPeriod(day(9), day(10)),
+			),
+			wantEnglish: "Jan 1, 2023, Jan 3–4, 2023 and Jan 9, 2023",
+			wantChinese: "2023年1月1日、2023年1月3日至4日和2023年1月9日",
+		},
+		{
+			name:        "Humanize_WithSinglePeriod",
+			sequence:    NewSequence(NewDefaultPeriod(day(1), day(2))),
+			wantEnglish: "Jan 1, 2023",
+			wantChinese: "2023年1月1日",
+		},
+		{
+			name:        "Humanize_WithEmptySequence",
+			sequence:    NewSequence(),
+			wantEnglish: "",
+			wantChinese: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.wantEnglish, tt.sequence.Humanize(English))
+				assert.Equal(t, tt.wantChinese, tt.sequence.Humanize(SimplifiedChinese))
+			},
+		)
+	}
+}
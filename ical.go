@@ -0,0 +1,615 @@
+package period
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	iCalProductID     = "-//maogou//period//EN"
+	iCalLineLength    = 75
+	iCalBoundaryField = "X-PERIOD-BOUNDARY"
+)
+
+var ErrInvalidICal = errors.New("period: invalid iCalendar data")
+
+var iCalDurationPattern = regexp.MustCompile(`^([+-])?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+type ICalEncoder struct {
+	w         io.Writer
+	productID string
+	stamp     time.Time
+}
+
+func NewICalEncoder(w io.Writer) ICalEncoder {
+	return ICalEncoder{
+		w:         w,
+		productID: iCalProductID,
+		stamp:     time.Now(),
+	}
+}
+
+func (e ICalEncoder) WithProductID(productID string) ICalEncoder {
+	e.productID = productID
+
+	return e
+}
+
+// WithStamp sets the DTSTAMP of the encoded components, defaults to the creation time of the encoder
+func (e ICalEncoder) WithStamp(stamp time.Time) ICalEncoder {
+	e.stamp = stamp
+
+	return e
+}
+
+func (e ICalEncoder) EncodeEvents(sequence Sequence) error {
+	lines := []string{"BEGIN:VCALENDAR", "VERSION:2.0", "PRODID:" + e.productID}
+
+	for index, period := range sequence.intervals {
+		lines = append(
+			lines,
+			"BEGIN:VEVENT",
+			fmt.Sprintf("UID:%s-%s-%d@period", formatICalTime(period.startDate), formatICalTime(period.endDate), index),
+			"DTSTAMP:"+formatICalTime(e.stamp),
+			"DTSTART:"+formatICalTime(period.startDate),
+			"DTEND:"+formatICalTime(period.endDate),
+		)
+
+		if boundaryType := period.GetBoundaryType(); boundaryType != IncludeStartExcludeEnd {
+			lines = append(lines, iCalBoundaryField+":"+boundaryType)
+		}
+
+		lines = append(lines, "END:VEVENT")
+	}
+
+	return e.write(append(lines, "END:VCALENDAR"))
+}
+
+func (e ICalEncoder) EncodeFreeBusy(sequence Sequence) error {
+	lines := []string{"BEGIN:VCALENDAR", "VERSION:2.0", "PRODID:" + e.productID, "BEGIN:VFREEBUSY", "DTSTAMP:" + formatICalTime(e.stamp)}
+
+	if !sequence.IsEmpty() {
+		bounds := sequence.Get(0).Merge(sequence.intervals...)
+		lines = append(lines, "DTSTART:"+formatICalTime(bounds.startDate), "DTEND:"+formatICalTime(bounds.endDate))
+	}
+
+	for _, period := range sequence.intervals {
+		lines = append(lines, "FREEBUSY:"+formatICalTime(period.startDate)+"/"+formatICalTime(period.endDate))
+	}
+
+	return e.write(append(lines, "END:VFREEBUSY", "END:VCALENDAR"))
+}
+
+func (e ICalEncoder) write(lines []string) error {
+	var builder strings.Builder
+
+	for _, line := range lines {
+		builder.WriteString(foldICalLine(line))
+		builder.WriteString("\r\n")
+	}
+
+	_, err := io.WriteString(e.w, builder.String())
+
+	return err
+}
+
+func formatICalTime(date time.Time) string {
+	return date.UTC().Format(iCalDateTimeLayout) + "Z"
+}
+
+// foldICalLine splits lines longer than 75 octets without breaking UTF-8 sequences
+func foldICalLine(line string) string {
+	var builder strings.Builder
+
+	length := 0
+	for _, c := range line {
+		size := len(string(c))
+		if length+size > iCalLineLength {
+			builder.WriteString("\r\n ")
+			length = 1
+		}
+
+		builder.WriteRune(c)
+		length += size
+	}
+
+	return builder.String()
+}
+
+type iCalProperty struct {
+	name   string
+	params map[string]string
+	value  string
+	line   int
+}
+
+type iCalComponent struct {
+	name       string
+	properties []iCalProperty
+	components []*iCalComponent
+}
+
+func (c *iCalComponent) property(name string) (iCalProperty, bool) {
+	for _, property := range c.properties {
+		if property.name == name {
+			return property, true
+		}
+	}
+
+	return iCalProperty{}, false
+}
+
+func (c *iCalComponent) all(name string) []iCalProperty {
+	var properties []iCalProperty
+
+	for _, property := range c.properties {
+		if property.name == name {
+			properties = append(properties, property)
+		}
+	}
+
+	return properties
+}
+
+// ICalResult keeps the busy time of VEVENT and VFREEBUSY apart from the free time of VAVAILABILITY and FBTYPE=FREE
+type ICalResult struct {
+	Busy Sequence
+	Free Sequence
+}
+
+// DecodeICal reads the VEVENT, FREEBUSY and AVAILABLE periods overlapping window, recurring components are expanded inside it
+func DecodeICal(r io.Reader, window Period) (ICalResult, error) {
+	root, err := parseICal(r)
+	if err != nil {
+		return ICalResult{}, err
+	}
+
+	decoder := iCalDecoder{timezones: map[string]*iCalComponent{}}
+	decoder.collectTimezones(root)
+
+	result := ICalResult{}
+	err = decoder.walk(
+		root, func(component *iCalComponent) error {
+			switch component.name {
+			case "VEVENT", "AVAILABLE":
+				periods, err := decoder.recurringComponent(component, window)
+				if component.name == "VEVENT" {
+					result.Busy = result.Busy.Push(periods...)
+				} else {
+					result.Free = result.Free.Push(periods...)
+				}
+
+				return err
+			case "VFREEBUSY":
+				busy, free, err := decoder.freeBusy(component)
+				result.Busy = result.Busy.Push(overlapping(busy, window)...)
+				result.Free = result.Free.Push(overlapping(free, window)...)
+
+				return err
+			}
+
+			return nil
+		},
+	)
+
+	if err != nil {
+		return ICalResult{}, err
+	}
+
+	return result, nil
+}
+
+func unfoldICal(r io.Reader) ([]string, []int, error) {
+	var lines []string
+	var numbers []int
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	number := 0
+	for scanner.Scan() {
+		number++
+		line := strings.TrimRight(scanner.Text(), "\r")
+
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+
+		if line == "" {
+			continue
+		}
+
+		lines = append(lines, line)
+		numbers = append(numbers, number)
+	}
+
+	return lines, numbers, scanner.Err()
+}
+
+func parseICal(r io.Reader) (*iCalComponent, error) {
+	lines, numbers, err := unfoldICal(r)
+	if err != nil {
+		return nil, err
+	}
+
+	root := &iCalComponent{}
+	stack := []*iCalComponent{root}
+
+	for i, line := range lines {
+		if !strings.Contains(line, ":") {
+			return nil, fmt.Errorf("%w: line %d: missing ':'", ErrInvalidICal, numbers[i])
+		}
+
+		name, params, value := splitContentLine(line)
+		current := stack[len(stack)-1]
+
+		switch name {
+		case "BEGIN":
+			component := &iCalComponent{name: strings.ToUpper(value)}
+			current.components = append(current.components, component)
+			stack = append(stack, component)
+		case "END":
+			if len(stack) == 1 || current.name != strings.ToUpper(value) {
+				return nil, fmt.Errorf("%w: line %d: unexpected END:%s", ErrInvalidICal, numbers[i], value)
+			}
+			stack = stack[:len(stack)-1]
+		default:
+			current.properties = append(
+				current.properties, iCalProperty{name: name, params: params, value: value, line: numbers[i]},
+			)
+		}
+	}
+
+	if len(stack) != 1 {
+		return nil, fmt.Errorf("%w: missing END:%s", ErrInvalidICal, stack[len(stack)-1].name)
+	}
+
+	return root, nil
+}
+
+type iCalDecoder struct {
+	timezones map[string]*iCalComponent
+}
+
+func (d iCalDecoder) collectTimezones(component *iCalComponent) {
+	for _, child := range component.components {
+		if child.name == "VTIMEZONE" {
+			if tzid, ok := child.property("TZID"); ok {
+				d.timezones[tzid.value] = child
+			}
+			continue
+		}
+
+		d.collectTimezones(child)
+	}
+}
+
+func (d iCalDecoder) walk(component *iCalComponent, callback func(*iCalComponent) error) error {
+	for _, child := range component.components {
+		if err := callback(child); err != nil {
+			return err
+		}
+
+		if err := d.walk(child, callback); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d iCalDecoder) time(property iCalProperty) (time.Time, error) {
+	date, err := d.parseTime(property.value, property.params)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: line %d: %s: %v", ErrInvalidICal, property.line, property.name, err)
+	}
+
+	return date, nil
+}
+
+func (d iCalDecoder) parseTime(value string, params map[string]string) (time.Time, error) {
+	tzid, ok := params["TZID"]
+	if !ok || strings.HasSuffix(value, "Z") {
+		date, _, err := parseICalTime(value, nil, time.UTC)
+		return date, err
+	}
+
+	if location, err := time.LoadLocation(tzid); err == nil {
+		date, _, err := parseICalTime(value, nil, location)
+		return date, err
+	}
+
+	timezone, ok := d.timezones[tzid]
+	if !ok {
+		return time.Time{}, fmt.Errorf("unknown TZID %q", tzid)
+	}
+
+	wall, _, err := parseICalTime(value, nil, time.UTC)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	offset, err := d.offset(timezone, wall)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return wall.Add(-time.Duration(offset) * time.Second).In(time.FixedZone(tzid, offset)), nil
+}
+
+// offset returns the UTC offset of the VTIMEZONE observance in effect at the given wall clock time
+func (d iCalDecoder) offset(timezone *iCalComponent, wall time.Time) (int, error) {
+	var latest, earliest time.Time
+	offset, fallback := 0, 0
+	found := false
+
+	for _, observance := range timezone.components {
+		property, ok := observance.property("DTSTART")
+		if !ok {
+			continue
+		}
+
+		onset, _, err := parseICalTime(property.value, nil, time.UTC)
+		if err != nil {
+			return 0, err
+		}
+
+		to, err := d.observanceOffset(observance, "TZOFFSETTO")
+		if err != nil {
+			return 0, err
+		}
+
+		if earliest.IsZero() || onset.Before(earliest) {
+			earliest = onset
+			fallback, err = d.observanceOffset(observance, "TZOFFSETFROM")
+			if err != nil {
+				return 0, err
+			}
+		}
+
+		onsets := []time.Time{onset}
+		if rule, ok := observance.property("RRULE"); ok {
+			recurrence, err := ParseRRule(rule.value)
+			if err != nil {
+				return 0, err
+			}
+			onsets = recurrence.WithStart(onset).Between(onset, wall)
+		}
+
+		for _, rDate := range observance.all("RDATE") {
+			dates, err := parseICalTimes(rDate.value, nil)
+			if err != nil {
+				return 0, err
+			}
+			onsets = append(onsets, dates...)
+		}
+
+		for _, candidate := range onsets {
+			if !candidate.After(wall) && (!found || candidate.After(latest)) {
+				latest, offset, found = candidate, to, true
+			}
+		}
+	}
+
+	if !found {
+		return fallback, nil
+	}
+
+	return offset, nil
+}
+
+func (d iCalDecoder) observanceOffset(observance *iCalComponent, name string) (int, error) {
+	property, ok := observance.property(name)
+	if !ok {
+		return 0, fmt.Errorf("missing %s", name)
+	}
+
+	return parseICalOffset(property.value)
+}
+
+func (d iCalDecoder) component(component *iCalComponent) (Period, error) {
+	property, ok := component.property("DTSTART")
+	if !ok {
+		return Period{}, fmt.Errorf("%w: %s without DTSTART", ErrInvalidICal, component.name)
+	}
+
+	startDate, err := d.time(property)
+	if err != nil {
+		return Period{}, err
+	}
+
+	endDate := startDate
+	if end, ok := component.property("DTEND"); ok {
+		if endDate, err = d.time(end); err != nil {
+			return Period{}, err
+		}
+	} else if duration, ok := component.property("DURATION"); ok {
+		if endDate, err = addICalDuration(startDate, duration.value); err != nil {
+			return Period{}, fmt.Errorf("%w: line %d: DURATION: %v", ErrInvalidICal, duration.line, err)
+		}
+	} else if property.params["VALUE"] == "DATE" || len(property.value) == len(iCalDateLayout) {
+		endDate = startDate.AddDate(0, 0, 1)
+	}
+
+	boundaryType := IncludeStartExcludeEnd
+	if boundary, ok := component.property(iCalBoundaryField); ok {
+		boundaryType = boundary.value
+	}
+
+	return NewPeriod(startDate, endDate, boundaryType), nil
+}
+
+// freeBusy returns the busy and the FBTYPE=FREE periods of a VFREEBUSY component
+func (d iCalDecoder) freeBusy(component *iCalComponent) ([]Period, []Period, error) {
+	var busy, free []Period
+
+	for _, property := range component.all("FREEBUSY") {
+		for _, value := range strings.Split(property.value, ",") {
+			start, end, ok := strings.Cut(value, "/")
+			if !ok {
+				return nil, nil, fmt.Errorf("%w: line %d: FREEBUSY: invalid period %q", ErrInvalidICal, property.line, value)
+			}
+
+			startDate, err := d.parseTime(start, property.params)
+			if err != nil {
+				return nil, nil, fmt.Errorf("%w: line %d: FREEBUSY: %v", ErrInvalidICal, property.line, err)
+			}
+
+			var endDate time.Time
+			if strings.Contains(end, "P") {
+				endDate, err = addICalDuration(startDate, end)
+			} else {
+				endDate, err = d.parseTime(end, property.params)
+			}
+
+			if err != nil {
+				return nil, nil, fmt.Errorf("%w: line %d: FREEBUSY: %v", ErrInvalidICal, property.line, err)
+			}
+
+			if fbType, ok := property.params["FBTYPE"]; ok && strings.ToUpper(fbType) == "FREE" {
+				free = append(free, NewDefaultPeriod(startDate, endDate))
+				continue
+			}
+
+			busy = append(busy, NewDefaultPeriod(startDate, endDate))
+		}
+	}
+
+	return sortICalPeriods(busy), sortICalPeriods(free), nil
+}
+
+func sortICalPeriods(periods []Period) []Period {
+	sort.SliceStable(
+		periods, func(i, j int) bool {
+			return periods[i].startDate.Before(periods[j].startDate)
+		},
+	)
+
+	return periods
+}
+
+// recurringComponent expands the RRULE, RDATE and EXDATE of a component inside window, a single occurrence without them
+func (d iCalDecoder) recurringComponent(component *iCalComponent, window Period) ([]Period, error) {
+	period, err := d.component(component)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := component.all("RRULE")
+	if len(rules) > 1 {
+		return nil, fmt.Errorf("%w: line %d: more than one RRULE", ErrInvalidICal, rules[1].line)
+	}
+
+	value, line := "FREQ=DAILY;COUNT=1", 0
+	if len(rules) == 1 {
+		value, line = rules[0].value, rules[0].line
+	}
+
+	rule, err := ParseRRule(value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: line %d: %v", ErrInvalidICal, line, err)
+	}
+
+	rDates, err := d.times(component.all("RDATE"))
+	if err != nil {
+		return nil, err
+	}
+
+	exDates, err := d.times(component.all("EXDATE"))
+	if err != nil {
+		return nil, err
+	}
+
+	rule = rule.
+		WithStart(period.startDate).
+		WithDuration(period.endDate.Sub(period.startDate)).
+		WithBoundaryType(period.GetBoundaryType()).
+		WithRDates(rDates...).
+		WithExDates(exDates...)
+
+	return rule.Expand(window).intervals, nil
+}
+
+func overlapping(periods []Period, window Period) []Period {
+	var selected []Period
+
+	for _, period := range periods {
+		if window.Overlaps(period) {
+			selected = append(selected, period)
+		}
+	}
+
+	return selected
+}
+
+// times parses the comma separated date-times of RDATE or EXDATE properties, PERIOD values are not supported
+func (d iCalDecoder) times(properties []iCalProperty) ([]time.Time, error) {
+	var dates []time.Time
+
+	for _, property := range properties {
+		for _, value := range strings.Split(property.value, ",") {
+			date, err := d.time(iCalProperty{name: property.name, params: property.params, value: value, line: property.line})
+			if err != nil {
+				return nil, err
+			}
+
+			dates = append(dates, date)
+		}
+	}
+
+	return dates, nil
+}
+
+// addICalDuration adds an RFC 5545 DURATION, days and weeks are nominal and follow the wall clock
+func addICalDuration(date time.Time, value string) (time.Time, error) {
+	matches := iCalDurationPattern.FindStringSubmatch(value)
+	if matches == nil || value == "P" || value == "PT" || strings.HasSuffix(value, "T") {
+		return time.Time{}, fmt.Errorf("invalid duration %q", value)
+	}
+
+	parts := make([]int, 5)
+	for i, match := range matches[2:] {
+		if match != "" {
+			parts[i], _ = strconv.Atoi(match)
+		}
+	}
+
+	sign := 1
+	if matches[1] == "-" {
+		sign = -1
+	}
+
+	clock := time.Duration(parts[2])*time.Hour + time.Duration(parts[3])*time.Minute + time.Duration(parts[4])*time.Second
+
+	return date.AddDate(0, 0, sign*(parts[0]*7+parts[1])).Add(time.Duration(sign) * clock), nil
+}
+
+func parseICalOffset(value string) (int, error) {
+	if len(value) != 5 && len(value) != 7 || value[0] != '+' && value[0] != '-' {
+		return 0, fmt.Errorf("invalid UTC offset %q", value)
+	}
+
+	digits, err := strconv.Atoi(value[1:])
+	if err != nil {
+		return 0, fmt.Errorf("invalid UTC offset %q", value)
+	}
+
+	if len(value) == 5 {
+		digits *= 100
+	}
+
+	offset := digits/10000*3600 + digits/100%100*60 + digits%100
+	if value[0] == '-' {
+		offset = -offset
+	}
+
+	return offset, nil
+}
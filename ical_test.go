@@ -0,0 +1,444 @@
+package period
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestICalEncoderEncodeEvents(t *testing.T) {
+	sequence := NewSequence(
+		NewDefaultPeriod(time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)),
+		NewIncludeAllPeriod(time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC), time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC)),
+	)
+
+	var buffer bytes.Buffer
+	err := NewICalEncoder(&buffer).WithStamp(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)).EncodeEvents(sequence)
+	assert.NoError(t, err)
+
+	want := strings.Join(
+		[]string{
+			"BEGIN:VCALENDAR",
+			"VERSION:2.0",
+			"PRODID:-//maogou//period//EN",
+			"BEGIN:VEVENT",
+			"UID:20240101T090000Z-20240101T100000Z-0@period",
+			"DTSTAMP:20240101T000000Z",
+			"DTSTART:20240101T090000Z",
+			"DTEND:20240101T100000Z",
+			"END:VEVENT",
+			"BEGIN:VEVENT",
+			"UID:20240102T090000Z-20240102T100000Z-1@period",
+			"DTSTAMP:20240101T000000Z",
+			"DTSTART:20240102T090000Z",
+			"DTEND:20240102T100000Z",
+			"X-PERIOD-BOUNDARY:[]",
+			"END:VEVENT",
+			"END:VCALENDAR",
+			"",
+		}, "\r\n",
+	)
+
+	assert.Equal(t, want, buffer.String())
+}
+
+func TestICalEncoderEncodeFreeBusy(t *testing.T) {
+	sequence := NewSequence(
+		NewDefaultPeriod(time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)),
+		NewDefaultPeriod(time.Date(2024, 1, 1, 14, 0, 0, 0, time.UTC), time.Date(2024, 1, 1, 15, 0, 0, 0, time.UTC)),
+	)
+
+	var buffer bytes.Buffer
+	err := NewICalEncoder(&buffer).WithStamp(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)).EncodeFreeBusy(sequence)
+	assert.NoError(t, err)
+
+	want := strings.Join(
+		[]string{
+			"BEGIN:VCALENDAR",
+			"VERSION:2.0",
+			"PRODID:-//maogou//period//EN",
+			"BEGIN:VFREEBUSY",
+			"DTSTAMP:20240101T000000Z",
+			"DTSTART:20240101T090000Z",
+			"DTEND:20240101T150000Z",
+			"FREEBUSY:20240101T090000Z/20240101T100000Z",
+			"FREEBUSY:20240101T140000Z/20240101T150000Z",
+			"END:VFREEBUSY",
+			"END:VCALENDAR",
+			"",
+		}, "\r\n",
+	)
+
+	assert.Equal(t, want, buffer.String())
+}
+
+func TestFoldICalLine(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+		want string
+	}{
+		{
+			name: "FoldICalLine_WithShortLine",
+			line: "DTSTART:20240101T090000Z",
+			want: "DTSTART:20240101T090000Z",
+		},
+		{
+			name: "FoldICalLine_WithLongLine",
+			line: "PRODID:" + strings.Repeat("a", 80),
+			want: "PRODID:" + strings.Repeat("a", 68) + "\r\n " + strings.Repeat("a", 12),
+		},
+		{
+			name: "FoldICalLine_WithMultiByteCharacters",
+			line: "PRODID:" + strings.Repeat("a", 67) + "时间",
+			want: "PRODID:" + strings.Repeat("a", 67) + "\r\n 时间",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.want, foldICalLine(tt.line))
+			},
+		)
+	}
+}
+
+func TestDecodeICal(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+
+	window := NewDefaultPeriod(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name     string
+		data     string
+		wantBusy Sequence
+		wantFree Sequence
+	}{
+		{
+			name: "DecodeICal_WithEventsAndTimezone",
+			data: "BEGIN:VCALENDAR\r\n" +
+				"BEGIN:VEVENT\r\n" +
+				"DTSTART;TZID=America/New_York:20240310T013000\r\n" +
+				"DTEND;TZID=America/New_York:20240310T033000\r\n" +
+				"END:VEVENT\r\n" +
+				"BEGIN:VEVENT\r\n" +
+				"DTSTART:20240311T090000Z\r\n" +
+				"DURATION:PT1H30M\r\n" +
+				"END:VEVENT\r\n" +
+				"BEGIN:VEVENT\r\n" +
+				"DTSTART;VALUE=DATE:20240312\r\n" +
+				"END:VEVENT\r\n" +
+				"END:VCALENDAR\r\n",
+			wantBusy: NewSequence(
+				NewDefaultPeriod(time.Date(2024, 3, 10, 1, 30, 0, 0, newYork), time.Date(2024, 3, 10, 3, 30, 0, 0, newYork)),
+				NewDefaultPeriod(time.Date(2024, 3, 11, 9, 0, 0, 0, time.UTC), time.Date(2024, 3, 11, 10, 30, 0, 0, time.UTC)),
+				NewDefaultPeriod(time.Date(2024, 3, 12, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 13, 0, 0, 0, 0, time.UTC)),
+			),
+		},
+		{
+			name: "DecodeICal_WithFoldedLines",
+			data: "BEGIN:VCALENDAR\n" +
+				"BEGIN:VEVENT\n" +
+				"DTSTART:20240311T0\n" +
+				" 90000Z\n" +
+				"DTEND:20240311T100000Z\n" +
+				"X-PERIOD-BOUNDARY:()\n" +
+				"END:VEVENT\n" +
+				"END:VCALENDAR\n",
+			wantBusy: NewSequence(
+				NewPeriod(time.Date(2024, 3, 11, 9, 0, 0, 0, time.UTC), time.Date(2024, 3, 11, 10, 0, 0, 0, time.UTC), ExcludeAll),
+			),
+		},
+		{
+			name: "DecodeICal_WithFreeBusy",
+			data: "BEGIN:VCALENDAR\r\n" +
+				"BEGIN:VFREEBUSY\r\n" +
+				"FREEBUSY:20240311T140000Z/PT1H,20240311T090000Z/20240311T100000Z\r\n" +
+				"FREEBUSY;FBTYPE=FREE:20240311T120000Z/20240311T130000Z\r\n" +
+				"END:VFREEBUSY\r\n" +
+				"END:VCALENDAR\r\n",
+			wantBusy: NewSequence(
+				NewDefaultPeriod(time.Date(2024, 3, 11, 9, 0, 0, 0, time.UTC), time.Date(2024, 3, 11, 10, 0, 0, 0, time.UTC)),
+				NewDefaultPeriod(time.Date(2024, 3, 11, 14, 0, 0, 0, time.UTC), time.Date(2024, 3, 11, 15, 0, 0, 0, time.UTC)),
+			),
+			wantFree: NewSequence(
+				NewDefaultPeriod(time.Date(2024, 3, 11, 12, 0, 0, 0, time.UTC), time.Date(2024, 3, 11, 13, 0, 0, 0, time.UTC)),
+			),
+		},
+		{
+			name: "DecodeICal_WithAvailability",
+			data: "BEGIN:VCALENDAR\r\n" +
+				"BEGIN:VAVAILABILITY\r\n" +
+				"DTSTART:20240301T000000Z\r\n" +
+				"BEGIN:AVAILABLE\r\n" +
+				"DTSTART:20240311T090000Z\r\n" +
+				"DTEND:20240311T170000Z\r\n" +
+				"END:AVAILABLE\r\n" +
+				"END:VAVAILABILITY\r\n" +
+				"END:VCALENDAR\r\n",
+			wantFree: NewSequence(
+				NewDefaultPeriod(time.Date(2024, 3, 11, 9, 0, 0, 0, time.UTC), time.Date(2024, 3, 11, 17, 0, 0, 0, time.UTC)),
+			),
+		},
+		{
+			name: "DecodeICal_WithCustomTimezone",
+			data: "BEGIN:VCALENDAR\r\n" +
+				"BEGIN:VTIMEZONE\r\n" +
+				"TZID:Custom/Zone\r\n" +
+				"BEGIN:STANDARD\r\n" +
+				"DTSTART:19701101T020000\r\n" +
+				"RRULE:FREQ=YEARLY;BYMONTH=11;BYDAY=1SU\r\n" +
+				"TZOFFSETFROM:-0400\r\n" +
+				"TZOFFSETTO:-0500\r\n" +
+				"END:STANDARD\r\n" +
+				"BEGIN:DAYLIGHT\r\n" +
+				"DTSTART:19700308T020000\r\n" +
+				"RRULE:FREQ=YEARLY;BYMONTH=3;BYDAY=2SU\r\n" +
+				"TZOFFSETFROM:-0500\r\n" +
+				"TZOFFSETTO:-0400\r\n" +
+				"END:DAYLIGHT\r\n" +
+				"END:VTIMEZONE\r\n" +
+				"BEGIN:VEVENT\r\n" +
+				"DTSTART;TZID=Custom/Zone:20240309T090000\r\n" +
+				"DTEND;TZID=Custom/Zone:20240311T090000\r\n" +
+				"END:VEVENT\r\n" +
+				"END:VCALENDAR\r\n",
+			wantBusy: NewSequence(
+				NewDefaultPeriod(time.Date(2024, 3, 9, 14, 0, 0, 0, time.UTC), time.Date(2024, 3, 11, 13, 0, 0, 0, time.UTC)),
+			),
+		},
+		{
+			name: "DecodeICal_WithRecurringEvent",
+			data: "BEGIN:VCALENDAR\r\n" +
+				"BEGIN:VEVENT\r\n" +
+				"DTSTART;TZID=America/New_York:20240308T090000\r\n" +
+				"DTEND;TZID=America/New_York:20240308T100000\r\n" +
+				"RRULE:FREQ=DAILY;COUNT=3\r\n" +
+				"EXDATE;TZID=America/New_York:20240309T090000\r\n" +
+				"RDATE:20240320T150000Z,20240321T150000Z\r\n" +
+				"END:VEVENT\r\n" +
+				"END:VCALENDAR\r\n",
+			wantBusy: NewSequence(
+				NewDefaultPeriod(time.Date(2024, 3, 8, 9, 0, 0, 0, newYork), time.Date(2024, 3, 8, 10, 0, 0, 0, newYork)),
+				NewDefaultPeriod(time.Date(2024, 3, 10, 9, 0, 0, 0, newYork), time.Date(2024, 3, 10, 10, 0, 0, 0, newYork)),
+				NewDefaultPeriod(time.Date(2024, 3, 20, 15, 0, 0, 0, time.UTC), time.Date(2024, 3, 20, 16, 0, 0, 0, time.UTC)),
+				NewDefaultPeriod(time.Date(2024, 3, 21, 15, 0, 0, 0, time.UTC), time.Date(2024, 3, 21, 16, 0, 0, 0, time.UTC)),
+			),
+		},
+		{
+			name: "DecodeICal_WithRecurringAvailability",
+			data: "BEGIN:VCALENDAR\r\n" +
+				"BEGIN:VAVAILABILITY\r\n" +
+				"BEGIN:AVAILABLE\r\n" +
+				"DTSTART:20240311T090000Z\r\n" +
+				"DTEND:20240311T170000Z\r\n" +
+				"RRULE:FREQ=WEEKLY;UNTIL=20240318T090000Z\r\n" +
+				"END:AVAILABLE\r\n" +
+				"END:VAVAILABILITY\r\n" +
+				"END:VCALENDAR\r\n",
+			wantFree: NewSequence(
+				NewDefaultPeriod(time.Date(2024, 3, 11, 9, 0, 0, 0, time.UTC), time.Date(2024, 3, 11, 17, 0, 0, 0, time.UTC)),
+				NewDefaultPeriod(time.Date(2024, 3, 18, 9, 0, 0, 0, time.UTC), time.Date(2024, 3, 18, 17, 0, 0, 0, time.UTC)),
+			),
+		},
+		{
+			name: "DecodeICal_WithExcludedStart",
+			data: "BEGIN:VEVENT\r\n" +
+				"DTSTART:20240311T090000Z\r\n" +
+				"DTEND:20240311T100000Z\r\n" +
+				"EXDATE:20240311T090000Z\r\n" +
+				"END:VEVENT\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, err := DecodeICal(strings.NewReader(tt.data), window)
+				assert.NoError(t, err)
+				assertICalSequence(t, tt.wantBusy, got.Busy)
+				assertICalSequence(t, tt.wantFree, got.Free)
+			},
+		)
+	}
+}
+
+func assertICalSequence(t *testing.T, want Sequence, got Sequence) {
+	assert.Equal(t, want.Count(), got.Count())
+	for i, period := range want.intervals {
+		assert.True(t, period.Equals(got.Get(i)), "%s != %s", period.Format(time.RFC3339), got.Get(i).Format(time.RFC3339))
+	}
+}
+
+func TestDecodeICalWindow(t *testing.T) {
+	data := "BEGIN:VCALENDAR\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"DTSTART:20240101T090000Z\r\n" +
+		"DTEND:20240101T100000Z\r\n" +
+		"RRULE:FREQ=WEEKLY\r\n" +
+		"END:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"DTSTART:20000101T000000Z\r\n" +
+		"DURATION:PT30S\r\n" +
+		"RRULE:FREQ=MINUTELY;UNTIL=99991231T000000Z\r\n" +
+		"END:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"DTSTART:20240301T090000Z\r\n" +
+		"DTEND:20240301T100000Z\r\n" +
+		"END:VEVENT\r\n" +
+		"BEGIN:VFREEBUSY\r\n" +
+		"FREEBUSY;FBTYPE=FREE:20240612T120000Z/PT1H,20240301T120000Z/PT1H\r\n" +
+		"END:VFREEBUSY\r\n" +
+		"END:VCALENDAR\r\n"
+
+	window := NewDefaultPeriod(time.Date(2024, 6, 10, 9, 0, 0, 0, time.UTC), time.Date(2024, 6, 17, 9, 2, 0, 0, time.UTC))
+
+	got, err := DecodeICal(strings.NewReader(data), window)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2+7*24*60+2, got.Busy.Count())
+	assert.True(t, NewDefaultPeriod(time.Date(2024, 6, 10, 9, 0, 0, 0, time.UTC), time.Date(2024, 6, 10, 10, 0, 0, 0, time.UTC)).Equals(got.Busy.Get(0)))
+	assert.True(t, NewDefaultPeriod(time.Date(2024, 6, 17, 9, 0, 0, 0, time.UTC), time.Date(2024, 6, 17, 10, 0, 0, 0, time.UTC)).Equals(got.Busy.Get(1)))
+	assertICalSequence(
+		t,
+		NewSequence(NewDefaultPeriod(time.Date(2024, 6, 12, 12, 0, 0, 0, time.UTC), time.Date(2024, 6, 12, 13, 0, 0, 0, time.UTC))),
+		got.Free,
+	)
+}
+
+func TestDecodeICalErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{
+			name: "DecodeICal_WithMissingColon",
+			data: "BEGIN:VCALENDAR\r\nINVALID\r\nEND:VCALENDAR\r\n",
+		},
+		{
+			name: "DecodeICal_WithUnbalancedComponents",
+			data: "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nEND:VCALENDAR\r\n",
+		},
+		{
+			name: "DecodeICal_WithUnclosedComponent",
+			data: "BEGIN:VCALENDAR\r\n",
+		},
+		{
+			name: "DecodeICal_WithoutStart",
+			data: "BEGIN:VEVENT\r\nDTEND:20240311T100000Z\r\nEND:VEVENT\r\n",
+		},
+		{
+			name: "DecodeICal_WithInvalidDate",
+			data: "BEGIN:VEVENT\r\nDTSTART:2024-03-11\r\nEND:VEVENT\r\n",
+		},
+		{
+			name: "DecodeICal_WithUnknownTimezone",
+			data: "BEGIN:VEVENT\r\nDTSTART;TZID=Unknown/Zone:20240311T090000\r\nEND:VEVENT\r\n",
+		},
+		{
+			name: "DecodeICal_WithInvalidDuration",
+			data: "BEGIN:VEVENT\r\nDTSTART:20240311T090000Z\r\nDURATION:1H\r\nEND:VEVENT\r\n",
+		},
+		{
+			name: "DecodeICal_WithInvalidFreeBusy",
+			data: "BEGIN:VFREEBUSY\r\nFREEBUSY:20240311T090000Z\r\nEND:VFREEBUSY\r\n",
+		},
+		{
+			name: "DecodeICal_WithInvalidRRule",
+			data: "BEGIN:VEVENT\r\nDTSTART:20240311T090000Z\r\nRRULE:FREQ=SOMETIMES;COUNT=2\r\nEND:VEVENT\r\n",
+		},
+		{
+			name: "DecodeICal_WithPeriodRDate",
+			data: "BEGIN:VEVENT\r\nDTSTART:20240311T090000Z\r\nRDATE;VALUE=PERIOD:20240312T090000Z/PT1H\r\nEND:VEVENT\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				_, err := DecodeICal(strings.NewReader(tt.data), NewDefaultPeriod(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)))
+				assert.ErrorIs(t, err, ErrInvalidICal)
+			},
+		)
+	}
+}
+
+func TestICalRoundTrip(t *testing.T) {
+	sequence := NewSequence(
+		NewPeriod(time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC), ExcludeStartIncludeEnd),
+		NewDefaultPeriod(time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC), time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC)),
+	)
+
+	var buffer bytes.Buffer
+	assert.NoError(t, NewICalEncoder(&buffer).EncodeEvents(sequence))
+
+	got, err := DecodeICal(&buffer, NewDefaultPeriod(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)))
+	assert.NoError(t, err)
+	assert.True(t, sequence.Equals(got.Busy))
+	assert.True(t, got.Free.IsEmpty())
+}
+
+func TestAddICalDuration(t *testing.T) {
+	date := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "AddICalDuration_WithWeeks", value: "P2W", want: date.AddDate(0, 0, 14)},
+		{name: "AddICalDuration_WithDaysAndTime", value: "P1DT2H30M15S", want: time.Date(2024, 1, 2, 11, 30, 15, 0, time.UTC)},
+		{name: "AddICalDuration_WithNegativeDuration", value: "-PT15M", want: time.Date(2024, 1, 1, 8, 45, 0, 0, time.UTC)},
+		{name: "AddICalDuration_WithEmptyDuration", value: "P", wantErr: true},
+		{name: "AddICalDuration_WithEmptyTime", value: "P1DT", wantErr: true},
+		{name: "AddICalDuration_WithInvalidDuration", value: "1H", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, err := addICalDuration(date, tt.value)
+				if tt.wantErr {
+					assert.Error(t, err)
+					return
+				}
+				assert.NoError(t, err)
+				assert.True(t, tt.want.Equal(got), "%s != %s", tt.want, got)
+			},
+		)
+	}
+}
+
+func TestParseICalOffset(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    int
+		wantErr bool
+	}{
+		{name: "ParseICalOffset_WithPositiveOffset", value: "+0100", want: 3600},
+		{name: "ParseICalOffset_WithNegativeOffset", value: "-0530", want: -19800},
+		{name: "ParseICalOffset_WithSeconds", value: "+001530", want: 930},
+		{name: "ParseICalOffset_WithoutSign", value: "0100", wantErr: true},
+		{name: "ParseICalOffset_WithLetters", value: "+01ab", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, err := parseICalOffset(tt.value)
+				if tt.wantErr {
+					assert.Error(t, err)
+					return
+				}
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			},
+		)
+	}
+}
@@ -0,0 +1,147 @@
+package period
+
+import (
+	"sort"
+	"time"
+)
+
+type intervalEntry[V comparable] struct {
+	period Period
+	value  V
+}
+
+// IntervalMap associates values with non overlapping periods, setting a value paints over the covered segments
+type IntervalMap[V comparable] struct {
+	entries []intervalEntry[V]
+}
+
+func NewIntervalMap[V comparable]() *IntervalMap[V] {
+	return &IntervalMap[V]{}
+}
+
+func (m *IntervalMap[V]) Len() int {
+	return len(m.entries)
+}
+
+func (m *IntervalMap[V]) IsEmpty() bool {
+	return len(m.entries) == 0
+}
+
+func (m *IntervalMap[V]) Sequence() Sequence {
+	periods := make([]Period, 0, len(m.entries))
+
+	for _, entry := range m.entries {
+		periods = append(periods, entry.period)
+	}
+
+	return NewSequence(periods...)
+}
+
+func (m *IntervalMap[V]) Set(period Period, value V) {
+	if period.isEmpty() {
+		return
+	}
+
+	m.Delete(period)
+
+	index := sort.Search(
+		len(m.entries), func(i int) bool {
+			return intervalEntryLess(period, m.entries[i].period)
+		},
+	)
+
+	m.entries = append(m.entries, intervalEntry[V]{})
+	copy(m.entries[index+1:], m.entries[index:])
+	m.entries[index] = intervalEntry[V]{period: period, value: value}
+
+	m.coalesce(index)
+	if index > 0 {
+		m.coalesce(index - 1)
+	}
+}
+
+func (m *IntervalMap[V]) Delete(period Period) {
+	if period.isEmpty() {
+		return
+	}
+
+	entries := make([]intervalEntry[V], 0, len(m.entries))
+
+	for _, entry := range m.entries {
+		for _, remaining := range subtractSorted([]Period{entry.period}, []Period{period}) {
+			entries = append(entries, intervalEntry[V]{period: remaining, value: entry.value})
+		}
+	}
+
+	m.entries = entries
+}
+
+// coalesce merges the entry at index with the next one when they abut and hold the same value
+func (m *IntervalMap[V]) coalesce(index int) {
+	if index < 0 || index+1 >= len(m.entries) {
+		return
+	}
+
+	current, next := m.entries[index], m.entries[index+1]
+	if current.value != next.value || !current.period.endDate.Equal(next.period.startDate) {
+		return
+	}
+
+	if current.period.IsEndIncluded() == next.period.IsStartIncluded() {
+		return
+	}
+
+	m.entries[index] = intervalEntry[V]{period: boundedPeriod(current.period, next.period), value: current.value}
+	m.entries = append(m.entries[:index+1], m.entries[index+2:]...)
+}
+
+func (m *IntervalMap[V]) Get(date time.Time) (V, bool) {
+	index := sort.Search(
+		len(m.entries), func(i int) bool {
+			return m.entries[i].period.startDate.After(date)
+		},
+	)
+
+	for i := index - 1; i >= 0 && !m.entries[i].period.endDate.Before(date); i-- {
+		entry := m.entries[i]
+		if entry.period.containsDatePoint(date, entry.period.GetBoundaryType()) {
+			return entry.value, true
+		}
+	}
+
+	var zero V
+
+	return zero, false
+}
+
+// Range calls callback with every segment clipped to the period, in chronological order, until it returns false
+func (m *IntervalMap[V]) Range(period Period, callback func(Period, V) bool) {
+	index := sort.Search(
+		len(m.entries), func(i int) bool {
+			return !m.entries[i].period.endDate.Before(period.startDate)
+		},
+	)
+
+	for _, entry := range m.entries[index:] {
+		if entry.period.startDate.After(period.endDate) {
+			return
+		}
+
+		clipped, ok := intersectPeriods(entry.period, period)
+		if !ok {
+			continue
+		}
+
+		if !callback(clipped, entry.value) {
+			return
+		}
+	}
+}
+
+func intervalEntryLess(period, other Period) bool {
+	if !period.startDate.Equal(other.startDate) {
+		return period.startDate.Before(other.startDate)
+	}
+
+	return period.IsStartIncluded() && !other.IsStartIncluded()
+}
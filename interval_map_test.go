@@ -0,0 +1,209 @@
+package period
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func intervalMapDay(d int) time.Time {
+	return time.Date(2023, 1, d, 0, 0, 0, 0, time.UTC)
+}
+
+type intervalMapSegment struct {
+	period Period
+	value  string
+}
+
+func collectIntervalMap(m *IntervalMap[string], window Period) []intervalMapSegment {
+	var segments []intervalMapSegment
+
+	m.Range(
+		window, func(period Period, value string) bool {
+			segments = append(segments, intervalMapSegment{period: period, value: value})
+			return true
+		},
+	)
+
+	return segments
+}
+
+func TestIntervalMapSet(t *testing.T) {
+	window := NewIncludeAllPeriod(intervalMapDay(1), intervalMapDay(31))
+
+	tests := []struct {
+		name  string
+		build func() *IntervalMap[string]
+		want  []intervalMapSegment
+	}{
+		{
+			name: "Set_WithOverwriteInTheMiddle",
+			build: func() *IntervalMap[string] {
+				m := NewIntervalMap[string]()
+				m.Set(NewDefaultPeriod(intervalMapDay(1), intervalMapDay(10)), "a")
+				m.Set(NewDefaultPeriod(intervalMapDay(4), intervalMapDay(6)), "b")
+				return m
+			},
+			want: []intervalMapSegment{
+				{period: NewDefaultPeriod(intervalMapDay(1), intervalMapDay(4)), value: "a"},
+				{period: NewDefaultPeriod(intervalMapDay(4), intervalMapDay(6)), value: "b"},
+				{period: NewDefaultPeriod(intervalMapDay(6), intervalMapDay(10)), value: "a"},
+			},
+		},
+		{
+			name: "Set_WithEqualValuesCoalesce",
+			build: func() *IntervalMap[string] {
+				m := NewIntervalMap[string]()
+				m.Set(NewDefaultPeriod(intervalMapDay(1), intervalMapDay(4)), "a")
+				m.Set(NewDefaultPeriod(intervalMapDay(6), intervalMapDay(9)), "a")
+				m.Set(NewDefaultPeriod(intervalMapDay(4), intervalMapDay(6)), "a")
+				return m
+			},
+			want: []intervalMapSegment{
+				{period: NewDefaultPeriod(intervalMapDay(1), intervalMapDay(9)), value: "a"},
+			},
+		},
+		{
+			name: "Set_WithOverwriteAcrossSegments",
+			build: func() *IntervalMap[string] {
+				m := NewIntervalMap[string]()
+				m.Set(NewDefaultPeriod(intervalMapDay(1), intervalMapDay(4)), "a")
+				m.Set(NewDefaultPeriod(intervalMapDay(4), intervalMapDay(8)), "b")
+				m.Set(NewDefaultPeriod(intervalMapDay(3), intervalMapDay(5)), "c")
+				return m
+			},
+			want: []intervalMapSegment{
+				{period: NewDefaultPeriod(intervalMapDay(1), intervalMapDay(3)), value: "a"},
+				{period: NewDefaultPeriod(intervalMapDay(3), intervalMapDay(5)), value: "c"},
+				{period: NewDefaultPeriod(intervalMapDay(5), intervalMapDay(8)), value: "b"},
+			},
+		},
+		{
+			name: "Set_WithIncludeAllOverwrite",
+			build: func() *IntervalMap[string] {
+				m := NewIntervalMap[string]()
+				m.Set(NewDefaultPeriod(intervalMapDay(1), intervalMapDay(10)), "a")
+				m.Set(NewIncludeAllPeriod(intervalMapDay(4), intervalMapDay(6)), "b")
+				return m
+			},
+			want: []intervalMapSegment{
+				{period: NewDefaultPeriod(intervalMapDay(1), intervalMapDay(4)), value: "a"},
+				{period: NewIncludeAllPeriod(intervalMapDay(4), intervalMapDay(6)), value: "b"},
+				{period: NewPeriod(intervalMapDay(6), intervalMapDay(10), ExcludeAll), value: "a"},
+			},
+		},
+		{
+			name: "Set_WithEmptyPeriod",
+			build: func() *IntervalMap[string] {
+				m := NewIntervalMap[string]()
+				m.Set(NewDefaultPeriod(intervalMapDay(1), intervalMapDay(1)), "a")
+				return m
+			},
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got := collectIntervalMap(tt.build(), window)
+				assert.Equal(t, len(tt.want), len(got))
+				for i := range tt.want {
+					assert.True(t, tt.want[i].period.Equals(got[i].period), "%v != %v", tt.want[i].period, got[i].period)
+					assert.Equal(t, tt.want[i].value, got[i].value)
+				}
+			},
+		)
+	}
+}
+
+func TestIntervalMapGet(t *testing.T) {
+	m := NewIntervalMap[string]()
+	m.Set(NewDefaultPeriod(intervalMapDay(1), intervalMapDay(5)), "a")
+	m.Set(NewPeriod(intervalMapDay(5), intervalMapDay(8), ExcludeStartIncludeEnd), "b")
+
+	tests := []struct {
+		name   string
+		date   time.Time
+		want   string
+		wantOk bool
+	}{
+		{name: "Get_WithIncludedStart", date: intervalMapDay(1), want: "a", wantOk: true},
+		{name: "Get_WithExcludedEnd", date: intervalMapDay(5), want: "", wantOk: false},
+		{name: "Get_WithIncludedEnd", date: intervalMapDay(8), want: "b", wantOk: true},
+		{name: "Get_WithInnerDate", date: intervalMapDay(6), want: "b", wantOk: true},
+		{name: "Get_WithDateBefore", date: intervalMapDay(0), want: "", wantOk: false},
+		{name: "Get_WithDateAfter", date: intervalMapDay(9), want: "", wantOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, ok := m.Get(tt.date)
+				assert.Equal(t, tt.wantOk, ok)
+				assert.Equal(t, tt.want, got)
+			},
+		)
+	}
+}
+
+func TestIntervalMapDelete(t *testing.T) {
+	m := NewIntervalMap[string]()
+	m.Set(NewDefaultPeriod(intervalMapDay(1), intervalMapDay(10)), "a")
+	m.Delete(NewDefaultPeriod(intervalMapDay(3), intervalMapDay(5)))
+
+	assert.Equal(t, 2, m.Len())
+	_, ok := m.Get(intervalMapDay(4))
+	assert.False(t, ok)
+	got, ok := m.Get(intervalMapDay(5))
+	assert.True(t, ok)
+	assert.Equal(t, "a", got)
+
+	m.Delete(NewDefaultPeriod(intervalMapDay(1), intervalMapDay(10)))
+	assert.True(t, m.IsEmpty())
+
+	m.Set(NewIncludeAllPeriod(intervalMapDay(1), intervalMapDay(3)), "v")
+	m.Delete(NewDefaultPeriod(intervalMapDay(1), intervalMapDay(3)))
+	assert.Equal(t, 1, m.Len())
+	assert.True(t, NewIncludeAllPeriod(intervalMapDay(3), intervalMapDay(3)).Equals(m.Sequence().Get(0)))
+	got, ok = m.Get(intervalMapDay(3))
+	assert.True(t, ok)
+	assert.Equal(t, "v", got)
+
+	m.Set(NewPeriod(intervalMapDay(3), intervalMapDay(6), ExcludeStartIncludeEnd), "v")
+	m.Delete(NewPeriod(intervalMapDay(1), intervalMapDay(6), ExcludeAll))
+	assert.Equal(t, 1, m.Len())
+	assert.True(t, NewIncludeAllPeriod(intervalMapDay(6), intervalMapDay(6)).Equals(m.Sequence().Get(0)))
+	_, ok = m.Get(intervalMapDay(3))
+	assert.False(t, ok)
+}
+
+func TestIntervalMapRange(t *testing.T) {
+	m := NewIntervalMap[string]()
+	m.Set(NewDefaultPeriod(intervalMapDay(1), intervalMapDay(5)), "a")
+	m.Set(NewDefaultPeriod(intervalMapDay(5), intervalMapDay(10)), "b")
+	m.Set(NewDefaultPeriod(intervalMapDay(12), intervalMapDay(15)), "c")
+
+	got := collectIntervalMap(m, NewDefaultPeriod(intervalMapDay(3), intervalMapDay(13)))
+	assert.Equal(t, 3, len(got))
+	assert.True(t, NewDefaultPeriod(intervalMapDay(3), intervalMapDay(5)).Equals(got[0].period))
+	assert.True(t, NewDefaultPeriod(intervalMapDay(12), intervalMapDay(13)).Equals(got[2].period))
+
+	count := 0
+	m.Range(
+		NewDefaultPeriod(intervalMapDay(1), intervalMapDay(15)), func(Period, string) bool {
+			count++
+			return false
+		},
+	)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, 3, m.Sequence().Count())
+
+	got = collectIntervalMap(m, NewPeriod(intervalMapDay(1), intervalMapDay(3), ExcludeAll))
+	assert.Equal(t, 1, len(got))
+	assert.True(t, NewPeriod(intervalMapDay(1), intervalMapDay(3), ExcludeAll).Equals(got[0].period), "%v", got[0].period)
+
+	got = collectIntervalMap(m, NewIncludeAllPeriod(intervalMapDay(10), intervalMapDay(12)))
+	assert.Equal(t, 1, len(got))
+	assert.True(t, NewIncludeAllPeriod(intervalMapDay(12), intervalMapDay(12)).Equals(got[0].period), "%v", got[0].period)
+}
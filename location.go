@@ -0,0 +1,45 @@
+package period
+
+import (
+	"time"
+)
+
+// In returns the period with both dates expressed in the location, the instants and bounds are unchanged
+func (p Period) In(location *time.Location) Period {
+	return Period{
+		startDate:    p.startDate.In(location),
+		endDate:      p.endDate.In(location),
+		boundaryType: p.boundaryType,
+	}
+}
+
+func (p Period) UTC() Period {
+	return p.In(time.UTC)
+}
+
+func (p Period) Local() Period {
+	return p.In(time.Local)
+}
+
+// FormatIn formats the period like Format, with both dates expressed in the location
+func (p Period) FormatIn(layout string, location *time.Location) string {
+	return p.In(location).Format(layout)
+}
+
+func (s Sequence) In(location *time.Location) Sequence {
+	intervals := make([]Period, 0, len(s.intervals))
+
+	for _, period := range s.intervals {
+		intervals = append(intervals, period.In(location))
+	}
+
+	return Sequence{intervals: intervals}
+}
+
+func (s Sequence) UTC() Sequence {
+	return s.In(time.UTC)
+}
+
+func (s Sequence) Local() Sequence {
+	return s.In(time.Local)
+}
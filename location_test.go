@@ -0,0 +1,77 @@
+package period
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestPeriodIn(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	assert.NoError(t, err)
+
+	period := NewPeriod(
+		time.Date(2023, 1, 1, 9, 0, 0, 0, tokyo),
+		time.Date(2023, 1, 1, 18, 0, 0, 0, tokyo),
+		ExcludeStartIncludeEnd,
+	)
+
+	tests := []struct {
+		name         string
+		got          Period
+		wantLocation *time.Location
+		wantHour     int
+	}{
+		{name: "In_WithUTC", got: period.UTC(), wantLocation: time.UTC, wantHour: 0},
+		{name: "In_WithLocal", got: period.Local(), wantLocation: time.Local, wantHour: period.GetStartDate().Local().Hour()},
+		{name: "In_WithFixedZone", got: period.In(time.FixedZone("UTC-5", -5*3600)), wantLocation: time.FixedZone("UTC-5", -5*3600), wantHour: 19},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.True(t, period.GetStartDate().Equal(tt.got.GetStartDate()))
+				assert.True(t, period.GetEndDate().Equal(tt.got.GetEndDate()))
+				assert.Equal(t, ExcludeStartIncludeEnd, tt.got.GetBoundaryType())
+				assert.Equal(t, tt.wantLocation.String(), tt.got.GetStartDate().Location().String())
+				assert.Equal(t, tt.wantLocation.String(), tt.got.GetEndDate().Location().String())
+				assert.Equal(t, tt.wantHour, tt.got.GetStartDate().Hour())
+			},
+		)
+	}
+}
+
+func TestPeriodFormatIn(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	assert.NoError(t, err)
+
+	period := NewPeriod(
+		time.Date(2023, 1, 1, 9, 0, 0, 0, tokyo),
+		time.Date(2023, 1, 1, 18, 0, 0, 0, tokyo),
+		ExcludeAll,
+	)
+
+	assert.Equal(t, "(2023-01-01 00:00:00,2023-01-01 09:00:00)", period.FormatIn(time.DateTime, time.UTC))
+	assert.Equal(t, "(2023-01-01 09:00:00,2023-01-01 18:00:00)", period.FormatIn(time.DateTime, tokyo))
+}
+
+func TestSequenceIn(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	assert.NoError(t, err)
+
+	sequence := NewSequence(
+		NewDefaultPeriod(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)),
+		NewIncludeAllPeriod(time.Date(2023, 1, 3, 0, 0, 0, 0, time.UTC), time.Date(2023, 1, 4, 0, 0, 0, 0, time.UTC)),
+	)
+
+	got := sequence.In(tokyo)
+	assert.Equal(t, 2, got.Count())
+	assert.Equal(t, 9, got.Get(0).GetStartDate().Hour())
+	assert.Equal(t, IncludeAll, got.Get(1).GetBoundaryType())
+	assert.True(t, sequence.Get(1).GetEndDate().Equal(got.Get(1).GetEndDate()))
+	assert.Equal(t, time.UTC, sequence.Get(0).GetStartDate().Location())
+
+	assert.Equal(t, time.UTC, got.UTC().Get(0).GetStartDate().Location())
+	assert.Equal(t, time.Local, got.Local().Get(0).GetStartDate().Location())
+	assert.Equal(t, 0, NewSequence().In(tokyo).Count())
+}
@@ -0,0 +1,162 @@
+package period
+
+import (
+	"time"
+)
+
+type DailyRange struct {
+	startHour   int
+	startMinute int
+	endHour     int
+	endMinute   int
+}
+
+func NewDailyRange(startHour, startMinute, endHour, endMinute int) DailyRange {
+	return DailyRange{
+		startHour:   startHour,
+		startMinute: startMinute,
+		endHour:     endHour,
+		endMinute:   endMinute,
+	}
+}
+
+// isOvernight a range whose end is not after its start ends on the next day, e.g. 22:00-06:00
+func (r DailyRange) isOvernight() bool {
+	return r.endHour*60+r.endMinute <= r.startHour*60+r.startMinute
+}
+
+func (r DailyRange) on(year int, month time.Month, day int, loc *time.Location) Period {
+	endDay := day
+	if r.isOvernight() {
+		endDay++
+	}
+
+	return NewDefaultPeriod(
+		time.Date(year, month, day, r.startHour, r.startMinute, 0, 0, loc),
+		time.Date(year, month, endDay, r.endHour, r.endMinute, 0, 0, loc),
+	)
+}
+
+type OpeningHours struct {
+	weekdays   map[time.Weekday][]DailyRange
+	breaks     map[time.Weekday][]DailyRange
+	exceptions map[string][]DailyRange
+}
+
+const openingHoursDateLayout = "2006-01-02"
+
+func NewOpeningHours() OpeningHours {
+	return OpeningHours{
+		weekdays:   map[time.Weekday][]DailyRange{},
+		breaks:     map[time.Weekday][]DailyRange{},
+		exceptions: map[string][]DailyRange{},
+	}
+}
+
+func (o OpeningHours) clone() OpeningHours {
+	other := NewOpeningHours()
+
+	for weekday, ranges := range o.weekdays {
+		other.weekdays[weekday] = append([]DailyRange{}, ranges...)
+	}
+
+	for weekday, ranges := range o.breaks {
+		other.breaks[weekday] = append([]DailyRange{}, ranges...)
+	}
+
+	for date, ranges := range o.exceptions {
+		other.exceptions[date] = append([]DailyRange{}, ranges...)
+	}
+
+	return other
+}
+
+func (o OpeningHours) Open(weekday time.Weekday, ranges ...DailyRange) OpeningHours {
+	other := o.clone()
+	other.weekdays[weekday] = append(other.weekdays[weekday], ranges...)
+
+	return other
+}
+
+func (o OpeningHours) Break(weekday time.Weekday, ranges ...DailyRange) OpeningHours {
+	other := o.clone()
+	other.breaks[weekday] = append(other.breaks[weekday], ranges...)
+
+	return other
+}
+
+// Exception replaces the weekly template (breaks included) for the given date, no ranges means closed all day
+func (o OpeningHours) Exception(year, month, day int, ranges ...DailyRange) OpeningHours {
+	other := o.clone()
+	date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC).Format(openingHoursDateLayout)
+	other.exceptions[date] = append([]DailyRange{}, ranges...)
+
+	return other
+}
+
+func (o OpeningHours) IsEmpty() bool {
+	for _, ranges := range o.weekdays {
+		if len(ranges) > 0 {
+			return false
+		}
+	}
+
+	for _, ranges := range o.exceptions {
+		if len(ranges) > 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (o OpeningHours) expandDay(year int, month time.Month, day int, loc *time.Location) Sequence {
+	sequence := Sequence{}
+	date := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+
+	if ranges, ok := o.exceptions[date.Format(openingHoursDateLayout)]; ok {
+		for _, r := range ranges {
+			sequence = sequence.Push(r.on(year, month, day, loc))
+		}
+
+		return sequence
+	}
+
+	for _, r := range o.weekdays[date.Weekday()] {
+		sequence = sequence.Push(r.on(year, month, day, loc))
+	}
+
+	breaks := Sequence{}
+	for _, r := range o.breaks[date.Weekday()] {
+		breaks = breaks.Push(r.on(year, month, day, loc))
+	}
+
+	return sequence.Subtract(breaks)
+}
+
+func (o OpeningHours) Expand(period Period, loc *time.Location) Sequence {
+	if loc == nil {
+		loc = time.Local
+	}
+
+	start := period.startDate.In(loc)
+	end := period.endDate.In(loc)
+
+	// start one day earlier so that overnight ranges of the previous day are taken into account
+	day := time.Date(start.Year(), start.Month(), start.Day()-1, 0, 0, 0, 0, time.UTC)
+	last := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, time.UTC)
+
+	sequence := Sequence{}
+
+	for !day.After(last) {
+		for _, interval := range o.expandDay(day.Year(), day.Month(), day.Day(), loc).intervals {
+			if intersect := period.Intersect(interval); !intersect.IsZero() {
+				sequence = sequence.Push(intersect)
+			}
+		}
+
+		day = day.AddDate(0, 0, 1)
+	}
+
+	return sequence.Unions()
+}
@@ -0,0 +1,269 @@
+package period
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestDailyRangeIsOvernight(t *testing.T) {
+	tests := []struct {
+		name       string
+		dailyRange DailyRange
+		want       bool
+	}{
+		{
+			name:       "IsOvernight_WithDaytimeRange",
+			dailyRange: NewDailyRange(9, 0, 18, 0),
+			want:       false,
+		},
+		{
+			name:       "IsOvernight_WithNightShift",
+			dailyRange: NewDailyRange(22, 0, 6, 0),
+			want:       true,
+		},
+		{
+			name:       "IsOvernight_WithFullDay",
+			dailyRange: NewDailyRange(0, 0, 0, 0),
+			want:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.want, tt.dailyRange.isOvernight())
+			},
+		)
+	}
+}
+
+func TestOpeningHoursIsEmpty(t *testing.T) {
+	tests := []struct {
+		name         string
+		openingHours OpeningHours
+		want         bool
+	}{
+		{
+			name:         "IsEmpty_WithNewOpeningHours",
+			openingHours: NewOpeningHours(),
+			want:         true,
+		},
+		{
+			name:         "IsEmpty_WithOnlyBreaks",
+			openingHours: NewOpeningHours().Break(time.Monday, NewDailyRange(12, 0, 13, 0)),
+			want:         true,
+		},
+		{
+			name:         "IsEmpty_WithWeekday",
+			openingHours: NewOpeningHours().Open(time.Monday, NewDailyRange(9, 0, 18, 0)),
+			want:         false,
+		},
+		{
+			name:         "IsEmpty_WithException",
+			openingHours: NewOpeningHours().Exception(2023, 1, 1, NewDailyRange(9, 0, 12, 0)),
+			want:         false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.want, tt.openingHours.IsEmpty())
+			},
+		)
+	}
+}
+
+func TestOpeningHoursIsImmutable(t *testing.T) {
+	origin := NewOpeningHours().Open(time.Monday, NewDailyRange(9, 0, 18, 0))
+	_ = origin.Open(time.Monday, NewDailyRange(19, 0, 20, 0))
+	_ = origin.Break(time.Monday, NewDailyRange(12, 0, 13, 0))
+
+	assert.Equal(t, []DailyRange{NewDailyRange(9, 0, 18, 0)}, origin.weekdays[time.Monday])
+	assert.Empty(t, origin.breaks[time.Monday])
+}
+
+func TestOpeningHoursExpand(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+
+	store := NewOpeningHours().
+		Open(time.Monday, NewDailyRange(9, 0, 18, 0)).
+		Open(time.Tuesday, NewDailyRange(9, 0, 18, 0)).
+		Open(time.Wednesday, NewDailyRange(9, 0, 18, 0)).
+		Open(time.Thursday, NewDailyRange(9, 0, 18, 0)).
+		Open(time.Friday, NewDailyRange(9, 0, 18, 0)).
+		Open(time.Saturday, NewDailyRange(10, 0, 14, 0)).
+		Break(time.Monday, NewDailyRange(12, 0, 13, 0)).
+		Break(time.Tuesday, NewDailyRange(12, 0, 13, 0)).
+		Break(time.Wednesday, NewDailyRange(12, 0, 13, 0)).
+		Break(time.Thursday, NewDailyRange(12, 0, 13, 0)).
+		Break(time.Friday, NewDailyRange(12, 0, 13, 0))
+
+	nightShift := NewOpeningHours().
+		Open(time.Saturday, NewDailyRange(22, 0, 6, 0)).
+		Open(time.Sunday, NewDailyRange(22, 0, 6, 0))
+
+	tests := []struct {
+		name         string
+		openingHours OpeningHours
+		period       Period
+		loc          *time.Location
+		want         []Period
+	}{
+		{
+			name:         "Expand_WithEmptyOpeningHours",
+			openingHours: NewOpeningHours(),
+			period: NewDefaultPeriod(
+				time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC),
+				time.Date(2023, 1, 9, 0, 0, 0, 0, time.UTC),
+			),
+			loc:  time.UTC,
+			want: []Period{},
+		},
+		{
+			name:         "Expand_WithLunchBreakAndWeekend",
+			openingHours: store,
+			period: NewDefaultPeriod(
+				time.Date(2023, 1, 6, 0, 0, 0, 0, time.UTC),
+				time.Date(2023, 1, 9, 0, 0, 0, 0, time.UTC),
+			),
+			loc: time.UTC,
+			want: []Period{
+				NewDefaultPeriod(
+					time.Date(2023, 1, 6, 9, 0, 0, 0, time.UTC),
+					time.Date(2023, 1, 6, 12, 0, 0, 0, time.UTC),
+				),
+				NewDefaultPeriod(
+					time.Date(2023, 1, 6, 13, 0, 0, 0, time.UTC),
+					time.Date(2023, 1, 6, 18, 0, 0, 0, time.UTC),
+				),
+				NewDefaultPeriod(
+					time.Date(2023, 1, 7, 10, 0, 0, 0, time.UTC),
+					time.Date(2023, 1, 7, 14, 0, 0, 0, time.UTC),
+				),
+			},
+		},
+		{
+			name:         "Expand_WithPeriodCuttingOpeningHours",
+			openingHours: store,
+			period: NewDefaultPeriod(
+				time.Date(2023, 1, 7, 11, 0, 0, 0, time.UTC),
+				time.Date(2023, 1, 7, 13, 0, 0, 0, time.UTC),
+			),
+			loc: time.UTC,
+			want: []Period{
+				NewDefaultPeriod(
+					time.Date(2023, 1, 7, 11, 0, 0, 0, time.UTC),
+					time.Date(2023, 1, 7, 13, 0, 0, 0, time.UTC),
+				),
+			},
+		},
+		{
+			name:         "Expand_WithClosedException",
+			openingHours: store.Exception(2023, 1, 6),
+			period: NewDefaultPeriod(
+				time.Date(2023, 1, 6, 0, 0, 0, 0, time.UTC),
+				time.Date(2023, 1, 7, 0, 0, 0, 0, time.UTC),
+			),
+			loc:  time.UTC,
+			want: []Period{},
+		},
+		{
+			name:         "Expand_WithSpecialHoursException",
+			openingHours: store.Exception(2023, 1, 6, NewDailyRange(10, 0, 15, 0)),
+			period: NewDefaultPeriod(
+				time.Date(2023, 1, 6, 0, 0, 0, 0, time.UTC),
+				time.Date(2023, 1, 7, 0, 0, 0, 0, time.UTC),
+			),
+			loc: time.UTC,
+			want: []Period{
+				NewDefaultPeriod(
+					time.Date(2023, 1, 6, 10, 0, 0, 0, time.UTC),
+					time.Date(2023, 1, 6, 15, 0, 0, 0, time.UTC),
+				),
+			},
+		},
+		{
+			name:         "Expand_WithOvernightShift",
+			openingHours: nightShift,
+			period: NewDefaultPeriod(
+				time.Date(2023, 1, 7, 0, 0, 0, 0, time.UTC),
+				time.Date(2023, 1, 9, 0, 0, 0, 0, time.UTC),
+			),
+			loc: time.UTC,
+			want: []Period{
+				NewDefaultPeriod(
+					time.Date(2023, 1, 7, 22, 0, 0, 0, time.UTC),
+					time.Date(2023, 1, 8, 6, 0, 0, 0, time.UTC),
+				),
+				NewDefaultPeriod(
+					time.Date(2023, 1, 8, 22, 0, 0, 0, time.UTC),
+					time.Date(2023, 1, 9, 0, 0, 0, 0, time.UTC),
+				),
+			},
+		},
+		{
+			name:         "Expand_WithOvernightShiftFromPreviousDay",
+			openingHours: nightShift,
+			period: NewDefaultPeriod(
+				time.Date(2023, 1, 9, 0, 0, 0, 0, time.UTC),
+				time.Date(2023, 1, 10, 0, 0, 0, 0, time.UTC),
+			),
+			loc: time.UTC,
+			want: []Period{
+				NewDefaultPeriod(
+					time.Date(2023, 1, 9, 0, 0, 0, 0, time.UTC),
+					time.Date(2023, 1, 9, 6, 0, 0, 0, time.UTC),
+				),
+			},
+		},
+		{
+			name:         "Expand_WithDaylightSavingTimeStart",
+			openingHours: nightShift,
+			period: NewDefaultPeriod(
+				time.Date(2023, 3, 11, 0, 0, 0, 0, newYork),
+				time.Date(2023, 3, 12, 12, 0, 0, 0, newYork),
+			),
+			loc: newYork,
+			want: []Period{
+				NewDefaultPeriod(
+					time.Date(2023, 3, 11, 22, 0, 0, 0, newYork),
+					time.Date(2023, 3, 12, 6, 0, 0, 0, newYork),
+				),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got := tt.openingHours.Expand(tt.period, tt.loc)
+				assert.Equal(t, len(tt.want), got.Count())
+				for i, want := range tt.want {
+					assert.True(t, want.Equals(got.Get(i)), "%s != %s", want.Format(time.RFC3339), got.Get(i).Format(time.RFC3339))
+				}
+			},
+		)
+	}
+}
+
+func TestOpeningHoursExpandDaylightSavingTimeDuration(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+
+	nightShift := NewOpeningHours().Open(time.Saturday, NewDailyRange(22, 0, 6, 0))
+
+	spring := nightShift.Expand(
+		NewDefaultPeriod(time.Date(2023, 3, 11, 0, 0, 0, 0, newYork), time.Date(2023, 3, 13, 0, 0, 0, 0, newYork)),
+		newYork,
+	)
+	assert.Equal(t, 7*time.Hour, spring.Get(0).GetDateInterval())
+
+	autumn := nightShift.Expand(
+		NewDefaultPeriod(time.Date(2023, 11, 4, 0, 0, 0, 0, newYork), time.Date(2023, 11, 6, 0, 0, 0, 0, newYork)),
+		newYork,
+	)
+	assert.Equal(t, 9*time.Hour, autumn.Get(0).GetDateInterval())
+}
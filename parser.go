@@ -0,0 +1,398 @@
+package period
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+var parserUnits = map[string]string{
+	"day":      CalendarDay,
+	"days":     CalendarDay,
+	"week":     CalendarWeek,
+	"weeks":    CalendarWeek,
+	"month":    CalendarMonth,
+	"months":   CalendarMonth,
+	"quarter":  calendarQuarter,
+	"quarters": calendarQuarter,
+	"year":     CalendarYear,
+	"years":    CalendarYear,
+}
+
+var (
+	parserYear     = regexp.MustCompile(`^(\d{4})$`)
+	parserMonth    = regexp.MustCompile(`^(\d{4})-(\d{2})$`)
+	parserDay      = regexp.MustCompile(`^(\d{4})-(\d{2})-(\d{2})$`)
+	parserQuarter  = regexp.MustCompile(`^(\d{4})-q([1-4])$`)
+	parserWeek     = regexp.MustCompile(`^(\d{4})-w(\d{2})$`)
+	parserClock12  = regexp.MustCompile(`^(\d{1,2})(?::(\d{2}))?(am|pm)$`)
+	parserClock24  = regexp.MustCompile(`^(\d{1,2}):(\d{2})$`)
+	parserQuarterQ = regexp.MustCompile(`^q([1-4])$`)
+)
+
+type ParseError struct {
+	Input  string
+	Offset int
+	Token  string
+	Err    error
+}
+
+func (e *ParseError) Error() string {
+	if e.Token == "" {
+		return fmt.Sprintf("period: parse %q at offset %d: %v", e.Input, e.Offset, e.Err)
+	}
+
+	return fmt.Sprintf("period: parse %q at offset %d (%q): %v", e.Input, e.Offset, e.Token, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+type parserToken struct {
+	value  string
+	offset int
+}
+
+// Parser turns expressions such as "last week", "Q3 2024" or "yesterday 9am to 5pm" into periods
+//
+//	expression := operand [ "to" operand ]
+//	operand    := date [ time ] | time
+//	date       := "today" | "yesterday" | "tomorrow"
+//	            | ( "this" | "last" | "next" ) unit
+//	            | ( "last" | "next" ) number unit
+//	            | "q1".."q4" yyyy | yyyy-qN | yyyy-Www | yyyy | yyyy-mm | yyyy-mm-dd
+//	unit       := day | week | month | quarter | year, optionally plural
+//	time       := 9am | 9:30pm | 17:00
+//
+// "last N units" ends with the current unit and "next N units" starts with it, a range ends at the start of
+// its right operand when that operand has a time, and at its end otherwise
+type Parser struct {
+	clock     Clock
+	location  *time.Location
+	weekStart time.Weekday
+}
+
+func NewParser() Parser {
+	return Parser{
+		clock:     SystemClock,
+		location:  time.Local,
+		weekStart: time.Monday,
+	}
+}
+
+func (p Parser) WithClock(clock Clock) Parser {
+	if clock != nil {
+		p.clock = clock
+	}
+
+	return p
+}
+
+func (p Parser) WithLocation(location *time.Location) Parser {
+	if location != nil {
+		p.location = location
+	}
+
+	return p
+}
+
+func (p Parser) WithWeekStart(weekStart time.Weekday) Parser {
+	p.weekStart = weekStart
+
+	return p
+}
+
+// Parse falls back to SystemClock and time.Local when the parser was not built with NewParser
+func (p Parser) Parse(input string) (Period, error) {
+	if p.clock == nil {
+		p.clock = SystemClock
+	}
+
+	if p.location == nil {
+		p.location = time.Local
+	}
+
+	tokens := tokenizeExpression(input)
+	if len(tokens) == 0 {
+		return Period{}, &ParseError{Input: input, Err: errors.New("empty expression")}
+	}
+
+	now := p.clock.Now().In(p.location)
+
+	to := -1
+	for i, token := range tokens {
+		if token.value != "to" {
+			continue
+		}
+
+		if to >= 0 || i == 0 || i == len(tokens)-1 {
+			return Period{}, p.errorAt(input, token, "unexpected range separator")
+		}
+		to = i
+	}
+
+	if to < 0 {
+		period, _, err := p.parseOperand(input, tokens, now)
+		return period, err
+	}
+
+	left, _, err := p.parseOperand(input, tokens[:to], now)
+	if err != nil {
+		return Period{}, err
+	}
+
+	right, hasTime, err := p.parseOperand(input, tokens[to+1:], left.startDate)
+	if err != nil {
+		return Period{}, err
+	}
+
+	endDate := right.endDate
+	if hasTime {
+		endDate = right.startDate
+	}
+
+	if endDate.Before(left.startDate) {
+		return Period{}, p.errorAt(input, tokens[to], "range ends before it starts")
+	}
+
+	return NewDefaultPeriod(left.startDate, endDate), nil
+}
+
+// parseOperand parses a date optionally followed by a time, a lone time refers to the day of base
+func (p Parser) parseOperand(input string, tokens []parserToken, base time.Time) (Period, bool, error) {
+	last := tokens[len(tokens)-1]
+
+	hour, minute, hasMinute, hasTime := parseClock(last.value)
+	if !hasTime {
+		period, err := p.parseDate(input, tokens)
+		return period, false, err
+	}
+
+	if hour < 0 {
+		return Period{}, false, p.errorAt(input, last, "invalid time of day")
+	}
+
+	day := calendarUnit(CalendarDay, base, 0, p.weekStart)
+	if len(tokens) > 1 {
+		var err error
+		if day, err = p.parseDate(input, tokens[:len(tokens)-1]); err != nil {
+			return Period{}, false, err
+		}
+
+		if !day.startDate.AddDate(0, 0, 1).Equal(day.endDate) {
+			return Period{}, false, p.errorAt(input, last, "a time of day requires a single day")
+		}
+	}
+
+	year, month, date := day.startDate.Date()
+	if hasMinute {
+		return Period{}.fromMinute(year, int(month), date, hour, minute, IncludeStartExcludeEnd, p.location), true, nil
+	}
+
+	return Period{}.fromHour(year, int(month), date, hour, IncludeStartExcludeEnd, p.location), true, nil
+}
+
+func (p Parser) parseDate(input string, tokens []parserToken) (Period, error) {
+	now := p.clock.Now().In(p.location)
+	first := tokens[0]
+
+	expect := func(count int) error {
+		if len(tokens) > count {
+			return p.errorAt(input, tokens[count], "unexpected token")
+		}
+
+		if len(tokens) < count {
+			return p.errorAt(input, parserToken{offset: len(input)}, "unexpected end of expression")
+		}
+
+		return nil
+	}
+
+	switch first.value {
+	case "today", "yesterday", "tomorrow":
+		if err := expect(1); err != nil {
+			return Period{}, err
+		}
+
+		offset := map[string]int{"today": 0, "yesterday": -1, "tomorrow": 1}[first.value]
+
+		return calendarUnit(CalendarDay, now, offset, p.weekStart), nil
+	case "this", "last", "next":
+		return p.parseRelative(input, tokens, now, expect)
+	}
+
+	if matches := parserQuarterQ.FindStringSubmatch(first.value); matches != nil {
+		if err := expect(2); err != nil {
+			return Period{}, err
+		}
+
+		if !parserYear.MatchString(tokens[1].value) {
+			return Period{}, p.errorAt(input, tokens[1], "expected a year")
+		}
+
+		year, _ := strconv.Atoi(tokens[1].value)
+		quarter, _ := strconv.Atoi(matches[1])
+
+		return Period{}.fromQuarter(year, quarter, IncludeStartExcludeEnd, p.location), nil
+	}
+
+	if err := expect(1); err != nil {
+		return Period{}, err
+	}
+
+	return p.parseAbsolute(input, first)
+}
+
+func (p Parser) parseRelative(input string, tokens []parserToken, now time.Time, expect func(int) error) (Period, error) {
+	if len(tokens) < 2 {
+		return Period{}, expect(2)
+	}
+
+	direction := map[string]int{"this": 0, "last": -1, "next": 1}[tokens[0].value]
+
+	if count, err := strconv.Atoi(tokens[1].value); err == nil && direction != 0 {
+		if err := expect(3); err != nil {
+			return Period{}, err
+		}
+
+		unit, ok := parserUnits[tokens[2].value]
+		if !ok {
+			return Period{}, p.errorAt(input, tokens[2], "unknown unit")
+		}
+
+		if count <= 0 {
+			return Period{}, p.errorAt(input, tokens[1], "expected a positive number")
+		}
+
+		if direction < 0 {
+			return calendarSpan(unit, now, 1-count, 0, p.weekStart), nil
+		}
+
+		return calendarSpan(unit, now, 0, count-1, p.weekStart), nil
+	}
+
+	unit, ok := parserUnits[tokens[1].value]
+	if !ok || strings.HasSuffix(tokens[1].value, "s") {
+		return Period{}, p.errorAt(input, tokens[1], "unknown unit")
+	}
+
+	if err := expect(2); err != nil {
+		return Period{}, err
+	}
+
+	return calendarUnit(unit, now, direction, p.weekStart), nil
+}
+
+func (p Parser) parseAbsolute(input string, token parserToken) (Period, error) {
+	atoi := func(value string) int {
+		number, _ := strconv.Atoi(value)
+		return number
+	}
+
+	if matches := parserYear.FindStringSubmatch(token.value); matches != nil {
+		return Period{}.fromYear(atoi(matches[1]), IncludeStartExcludeEnd, p.location), nil
+	}
+
+	if matches := parserQuarter.FindStringSubmatch(token.value); matches != nil {
+		return Period{}.fromQuarter(atoi(matches[1]), atoi(matches[2]), IncludeStartExcludeEnd, p.location), nil
+	}
+
+	if matches := parserMonth.FindStringSubmatch(token.value); matches != nil {
+		month := atoi(matches[2])
+		if month < 1 || month > 12 {
+			return Period{}, p.errorAt(input, token, "invalid month")
+		}
+
+		return Period{}.fromMonth(atoi(matches[1]), month, IncludeStartExcludeEnd, p.location), nil
+	}
+
+	if matches := parserDay.FindStringSubmatch(token.value); matches != nil {
+		year, month, day := atoi(matches[1]), atoi(matches[2]), atoi(matches[3])
+
+		date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, p.location)
+		if date.Year() != year || int(date.Month()) != month || date.Day() != day {
+			return Period{}, p.errorAt(input, token, "invalid date")
+		}
+
+		return Period{}.fromDay(year, month, day, IncludeStartExcludeEnd, p.location), nil
+	}
+
+	if matches := parserWeek.FindStringSubmatch(token.value); matches != nil {
+		year, week := atoi(matches[1]), atoi(matches[2])
+
+		january4 := time.Date(year, time.January, 4, 0, 0, 0, 0, p.location)
+		monday := january4.AddDate(0, 0, -(int(january4.Weekday())+6)%7+(week-1)*7)
+
+		if isoYear, isoWeek := monday.ISOWeek(); week < 1 || isoYear != year || isoWeek != week {
+			return Period{}, p.errorAt(input, token, "invalid ISO week")
+		}
+
+		return NewDefaultPeriod(monday, monday.AddDate(0, 0, 7)), nil
+	}
+
+	return Period{}, p.errorAt(input, token, "unexpected token")
+}
+
+func (p Parser) errorAt(input string, token parserToken, message string) error {
+	return &ParseError{Input: input, Offset: token.offset, Token: token.value, Err: errors.New(message)}
+}
+
+// parseClock reads 9am, 9:30pm or 17:00, hour is negative when the token looks like a time but is out of range
+func parseClock(value string) (hour, minute int, hasMinute, ok bool) {
+	if matches := parserClock12.FindStringSubmatch(value); matches != nil {
+		hour, _ = strconv.Atoi(matches[1])
+		if matches[2] != "" {
+			minute, _ = strconv.Atoi(matches[2])
+		}
+
+		if hour < 1 || hour > 12 || minute > 59 {
+			return -1, 0, false, true
+		}
+
+		hour %= 12
+		if matches[3] == "pm" {
+			hour += 12
+		}
+
+		return hour, minute, matches[2] != "", true
+	}
+
+	if matches := parserClock24.FindStringSubmatch(value); matches != nil {
+		hour, _ = strconv.Atoi(matches[1])
+		minute, _ = strconv.Atoi(matches[2])
+
+		if hour > 23 || minute > 59 {
+			return -1, 0, false, true
+		}
+
+		return hour, minute, true, true
+	}
+
+	return 0, 0, false, false
+}
+
+func tokenizeExpression(input string) []parserToken {
+	var tokens []parserToken
+
+	start := -1
+	for i, r := range input + " " {
+		if !unicode.IsSpace(r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+
+		if start >= 0 {
+			tokens = append(tokens, parserToken{value: strings.ToLower(input[start:i]), offset: start})
+			start = -1
+		}
+	}
+
+	return tokens
+}
@@ -0,0 +1,182 @@
+package period
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestParserParse(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+
+	// Wednesday, Aug 14th 2024
+	now := time.Date(2024, 8, 14, 15, 30, 0, 0, newYork)
+	parser := NewParser().WithClock(NewFixedClock(now)).WithLocation(newYork)
+
+	date := func(year, month, day, hour, minute int) time.Time {
+		return time.Date(year, time.Month(month), day, hour, minute, 0, 0, newYork)
+	}
+
+	tests := []struct {
+		name   string
+		parser Parser
+		input  string
+		want   Period
+	}{
+		{name: "Parse_WithToday", parser: parser, input: "today", want: NewDefaultPeriod(date(2024, 8, 14, 0, 0), date(2024, 8, 15, 0, 0))},
+		{name: "Parse_WithYesterday", parser: parser, input: "Yesterday", want: NewDefaultPeriod(date(2024, 8, 13, 0, 0), date(2024, 8, 14, 0, 0))},
+		{name: "Parse_WithTomorrow", parser: parser, input: "tomorrow", want: NewDefaultPeriod(date(2024, 8, 15, 0, 0), date(2024, 8, 16, 0, 0))},
+		{name: "Parse_WithLastWeek", parser: parser, input: "last week", want: NewDefaultPeriod(date(2024, 8, 5, 0, 0), date(2024, 8, 12, 0, 0))},
+		{name: "Parse_WithThisWeekStartingSunday", parser: parser.WithWeekStart(time.Sunday), input: "this week", want: NewDefaultPeriod(date(2024, 8, 11, 0, 0), date(2024, 8, 18, 0, 0))},
+		{name: "Parse_WithNextMonth", parser: parser, input: "next month", want: NewDefaultPeriod(date(2024, 9, 1, 0, 0), date(2024, 10, 1, 0, 0))},
+		{name: "Parse_WithThisQuarter", parser: parser, input: "this quarter", want: NewDefaultPeriod(date(2024, 7, 1, 0, 0), date(2024, 10, 1, 0, 0))},
+		{name: "Parse_WithLastQuarterAcrossYears", parser: NewParser().WithClock(NewFixedClock(date(2024, 2, 1, 0, 0))).WithLocation(newYork), input: "last quarter", want: NewDefaultPeriod(date(2023, 10, 1, 0, 0), date(2024, 1, 1, 0, 0))},
+		{name: "Parse_WithLastYear", parser: parser, input: "last year", want: NewDefaultPeriod(date(2023, 1, 1, 0, 0), date(2024, 1, 1, 0, 0))},
+		{name: "Parse_WithLastThirtyDays", parser: parser, input: "last 30 days", want: NewDefaultPeriod(date(2024, 7, 16, 0, 0), date(2024, 8, 15, 0, 0))},
+		{name: "Parse_WithNextTwoWeeks", parser: parser, input: "next 2 weeks", want: NewDefaultPeriod(date(2024, 8, 12, 0, 0), date(2024, 8, 26, 0, 0))},
+		{name: "Parse_WithQuarterAndYear", parser: parser, input: "Q3 2024", want: NewDefaultPeriod(date(2024, 7, 1, 0, 0), date(2024, 10, 1, 0, 0))},
+		{name: "Parse_WithCompactQuarter", parser: parser, input: "2024-Q1", want: NewDefaultPeriod(date(2024, 1, 1, 0, 0), date(2024, 4, 1, 0, 0))},
+		{name: "Parse_WithIsoWeek", parser: parser, input: "2024-W12", want: NewDefaultPeriod(date(2024, 3, 18, 0, 0), date(2024, 3, 25, 0, 0))},
+		{name: "Parse_WithIsoWeekOne", parser: parser, input: "2021-W01", want: NewDefaultPeriod(date(2021, 1, 4, 0, 0), date(2021, 1, 11, 0, 0))},
+		{name: "Parse_WithYear", parser: parser, input: "2023", want: NewDefaultPeriod(date(2023, 1, 1, 0, 0), date(2024, 1, 1, 0, 0))},
+		{name: "Parse_WithMonth", parser: parser, input: "2023-02", want: NewDefaultPeriod(date(2023, 2, 1, 0, 0), date(2023, 3, 1, 0, 0))},
+		{name: "Parse_WithDayOverDST", parser: parser, input: "2024-03-10", want: NewDefaultPeriod(date(2024, 3, 10, 0, 0), date(2024, 3, 11, 0, 0))},
+		{name: "Parse_WithTimeOfDay", parser: parser, input: "today 9:30am", want: NewDefaultPeriod(date(2024, 8, 14, 9, 30), date(2024, 8, 14, 9, 31))},
+		{name: "Parse_WithLoneTime", parser: parser, input: "5pm", want: NewDefaultPeriod(date(2024, 8, 14, 17, 0), date(2024, 8, 14, 18, 0))},
+		{name: "Parse_WithTimeRange", parser: parser, input: "yesterday 9am to 5pm", want: NewDefaultPeriod(date(2024, 8, 13, 9, 0), date(2024, 8, 13, 17, 0))},
+		{name: "Parse_WithTwentyFourHourRange", parser: parser, input: "2024-01-02 08:00 to 2024-01-03 18:30", want: NewDefaultPeriod(date(2024, 1, 2, 8, 0), date(2024, 1, 3, 18, 30))},
+		{name: "Parse_WithDayRange", parser: parser, input: "2024-01-01 to 2024-01-05", want: NewDefaultPeriod(date(2024, 1, 1, 0, 0), date(2024, 1, 6, 0, 0))},
+		{name: "Parse_WithMidnightAndNoon", parser: parser, input: "today 12am to 12pm", want: NewDefaultPeriod(date(2024, 8, 14, 0, 0), date(2024, 8, 14, 12, 0))},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, err := tt.parser.Parse(tt.input)
+				assert.NoError(t, err)
+				assert.True(t, tt.want.Equals(got), "%v != %v", tt.want, got)
+			},
+		)
+	}
+}
+
+func TestParserParseZeroValue(t *testing.T) {
+	got, err := Parser{}.Parse("2024-08-14")
+	assert.NoError(t, err)
+	assert.True(t, NewDefaultPeriod(time.Date(2024, 8, 14, 0, 0, 0, 0, time.Local), time.Date(2024, 8, 15, 0, 0, 0, 0, time.Local)).Equals(got))
+
+	got, err = Parser{}.Parse("today")
+	assert.NoError(t, err)
+	assert.True(t, got.IsCurrent(SystemClock))
+}
+
+func TestParserParseError(t *testing.T) {
+	parser := NewParser().WithClock(NewFixedClock(time.Date(2024, 8, 14, 0, 0, 0, 0, time.UTC))).WithLocation(time.UTC)
+
+	tests := []struct {
+		name       string
+		input      string
+		wantOffset int
+		wantToken  string
+		wantError  string
+	}{
+		{
+			name:       "Parse_WithEmptyExpression",
+			input:      "  ",
+			wantOffset: 0,
+			wantToken:  "",
+			wantError:  `period: parse "  " at offset 0: empty expression`,
+		},
+		{
+			name:       "Parse_WithUnknownUnit",
+			input:      "last 3 fortnights",
+			wantOffset: 7,
+			wantToken:  "fortnights",
+			wantError:  `period: parse "last 3 fortnights" at offset 7 ("fortnights"): unknown unit`,
+		},
+		{
+			name:       "Parse_WithPluralUnit",
+			input:      "last weeks",
+			wantOffset: 5,
+			wantToken:  "weeks",
+		},
+		{
+			name:       "Parse_WithTrailingToken",
+			input:      "today please",
+			wantOffset: 6,
+			wantToken:  "please",
+		},
+		{
+			name:       "Parse_WithMissingYear",
+			input:      "Q3",
+			wantOffset: 2,
+			wantToken:  "",
+		},
+		{
+			name:       "Parse_WithInvalidMonth",
+			input:      "2024-13",
+			wantOffset: 0,
+			wantToken:  "2024-13",
+		},
+		{
+			name:       "Parse_WithInvalidDate",
+			input:      "2023-02-29",
+			wantOffset: 0,
+			wantToken:  "2023-02-29",
+		},
+		{
+			name:       "Parse_WithInvalidIsoWeek",
+			input:      "2021-W53",
+			wantOffset: 0,
+			wantToken:  "2021-w53",
+		},
+		{
+			name:       "Parse_WithInvalidTime",
+			input:      "today 13pm",
+			wantOffset: 6,
+			wantToken:  "13pm",
+		},
+		{
+			name:       "Parse_WithTimeOnSeveralDays",
+			input:      "last week 9am",
+			wantOffset: 10,
+			wantToken:  "9am",
+		},
+		{
+			name:       "Parse_WithDanglingSeparator",
+			input:      "today to",
+			wantOffset: 6,
+			wantToken:  "to",
+		},
+		{
+			name:       "Parse_WithReversedRange",
+			input:      "today 5pm to 9am",
+			wantOffset: 10,
+			wantToken:  "to",
+		},
+		{
+			name:       "Parse_WithNonPositiveCount",
+			input:      "last 0 days",
+			wantOffset: 5,
+			wantToken:  "0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				_, err := parser.Parse(tt.input)
+
+				var parseErr *ParseError
+				assert.True(t, errors.As(err, &parseErr))
+				assert.Equal(t, tt.wantOffset, parseErr.Offset)
+				assert.Equal(t, tt.wantToken, parseErr.Token)
+				if tt.wantError != "" {
+					assert.EqualError(t, err, tt.wantError)
+				}
+			},
+		)
+	}
+}
@@ -57,9 +57,13 @@ func (p Period) FromPeriod(period Period, boundaryType string) Period {
 }
 
 func (p Period) FromYear(year int, boundaryType string) Period {
+	return p.fromYear(year, boundaryType, time.Local)
+}
+
+func (p Period) fromYear(year int, boundaryType string, location *time.Location) Period {
 	return Period{
-		startDate:    time.Date(year, 1, 1, 0, 0, 0, 0, time.Local),
-		endDate:      time.Date(year+1, 1, 1, 0, 0, 0, 0, time.Local),
+		startDate:    time.Date(year, 1, 1, 0, 0, 0, 0, location),
+		endDate:      time.Date(year+1, 1, 1, 0, 0, 0, 0, location),
 		boundaryType: boundaryType,
 	}
 }
@@ -84,45 +88,63 @@ func (p Period) FromSemester(year, semester int, boundaryType string) Period {
 }
 
 func (p Period) FromQuarter(year, quarter int, boundaryType string) Period {
+	return p.fromQuarter(year, quarter, boundaryType, time.Local)
+}
+
+func (p Period) fromQuarter(year, quarter int, boundaryType string, location *time.Location) Period {
 	startMonth := (quarter-1)*3 + 1
 
 	return Period{
-		startDate:    time.Date(year, time.Month(startMonth), 1, 0, 0, 0, 0, time.Local),
-		endDate:      time.Date(year, time.Month(startMonth+3), 1, 0, 0, 0, 0, time.Local),
+		startDate:    time.Date(year, time.Month(startMonth), 1, 0, 0, 0, 0, location),
+		endDate:      time.Date(year, time.Month(startMonth+3), 1, 0, 0, 0, 0, location),
 		boundaryType: boundaryType,
 	}
-
 }
 
 func (p Period) FromMonth(year, month int, boundaryType string) Period {
+	return p.fromMonth(year, month, boundaryType, time.Local)
+}
+
+func (p Period) fromMonth(year, month int, boundaryType string, location *time.Location) Period {
 	return Period{
-		startDate:    time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.Local),
-		endDate:      time.Date(year, time.Month(month+1), 1, 0, 0, 0, 0, time.Local),
+		startDate:    time.Date(year, time.Month(month), 1, 0, 0, 0, 0, location),
+		endDate:      time.Date(year, time.Month(month+1), 1, 0, 0, 0, 0, location),
 		boundaryType: boundaryType,
 	}
 }
 
 func (p Period) FromDay(year, month, day int, boundaryType string) Period {
+	return p.fromDay(year, month, day, boundaryType, time.Local)
+}
+
+func (p Period) fromDay(year, month, day int, boundaryType string, location *time.Location) Period {
 	return Period{
-		startDate:    time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.Local),
-		endDate:      time.Date(year, time.Month(month), day+1, 0, 0, 0, 0, time.Local),
+		startDate:    time.Date(year, time.Month(month), day, 0, 0, 0, 0, location),
+		endDate:      time.Date(year, time.Month(month), day+1, 0, 0, 0, 0, location),
 		boundaryType: boundaryType,
 	}
-
 }
 
 func (p Period) FromHour(year, month, day, hour int, boundaryType string) Period {
+	return p.fromHour(year, month, day, hour, boundaryType, time.Local)
+}
+
+func (p Period) fromHour(year, month, day, hour int, boundaryType string, location *time.Location) Period {
 	return Period{
-		startDate:    time.Date(year, time.Month(month), day, hour, 0, 0, 0, time.Local),
-		endDate:      time.Date(year, time.Month(month), day, hour+1, 0, 0, 0, time.Local),
+		startDate:    time.Date(year, time.Month(month), day, hour, 0, 0, 0, location),
+		endDate:      time.Date(year, time.Month(month), day, hour+1, 0, 0, 0, location),
 		boundaryType: boundaryType,
 	}
 }
 
 func (p Period) FromMinute(year, month, day, hour, minute int, boundaryType string) Period {
+	return p.fromMinute(year, month, day, hour, minute, boundaryType, time.Local)
+}
+
+func (p Period) fromMinute(year, month, day, hour, minute int, boundaryType string, location *time.Location) Period {
 	return Period{
-		startDate:    time.Date(year, time.Month(month), day, hour, minute, 0, 0, time.Local),
-		endDate:      time.Date(year, time.Month(month), day, hour, minute+1, 0, 0, time.Local),
+		startDate:    time.Date(year, time.Month(month), day, hour, minute, 0, 0, location),
+		endDate:      time.Date(year, time.Month(month), day, hour, minute+1, 0, 0, location),
 		boundaryType: boundaryType,
 	}
 }
@@ -282,6 +304,11 @@ func (p Period) IsZero() bool {
 	return p.startDate.IsZero() && p.endDate.IsZero()
 }
 
+// isEmpty a period without any date point, e.g. [a,a) or (a,a)
+func (p Period) isEmpty() bool {
+	return p.startDate.After(p.endDate) || p.startDate.Equal(p.endDate) && p.GetBoundaryType() != IncludeAll
+}
+
 func (p Period) Equals(other Period) bool {
 	return p.startDate.Equal(other.startDate) && p.endDate.Equal(other.endDate) && p.boundaryType == other.boundaryType
 }
@@ -565,6 +592,63 @@ func (p Period) Subtract(other Period) Sequence {
 	return Sequence{intervals: filtered}
 }
 
+// endsBefore reports whether p stops covering time before other does
+func (p Period) endsBefore(other Period) bool {
+	if !p.endDate.Equal(other.endDate) {
+		return p.endDate.Before(other.endDate)
+	}
+
+	return !boundaryIsEndIncluded(p.GetBoundaryType()) && boundaryIsEndIncluded(other.GetBoundaryType())
+}
+
+// before returns the part of p preceding other, it may be empty
+func (p Period) before(other Period) Period {
+	boundaryType := boundaryIncludeEnd(p.GetBoundaryType())
+	if boundaryIsStartIncluded(other.GetBoundaryType()) {
+		boundaryType = boundaryExcludeEnd(boundaryType)
+	}
+
+	endDate := other.startDate
+	if endDate.After(p.endDate) {
+		endDate = p.endDate
+	}
+
+	return Period{startDate: p.startDate, endDate: endDate, boundaryType: boundaryType}
+}
+
+// after returns the part of p following other, ok is false when other covers the end of p
+func (p Period) after(other Period) (Period, bool) {
+	if !other.endsBefore(p) {
+		return Period{}, false
+	}
+
+	boundaryType := boundaryIncludeStart(p.GetBoundaryType())
+	if boundaryIsEndIncluded(other.GetBoundaryType()) {
+		boundaryType = boundaryExcludeStart(boundaryType)
+	}
+
+	startDate := other.endDate
+	if startDate.Before(p.startDate) {
+		startDate = p.startDate
+	}
+
+	return Period{startDate: startDate, endDate: p.endDate, boundaryType: boundaryType}, true
+}
+
+// closeTo reports whether other starts no later than tolerance after p ends
+func (p Period) closeTo(other Period, tolerance time.Duration, abutting bool) bool {
+	gap := other.startDate.Sub(p.endDate)
+	if gap < 0 {
+		return false
+	}
+
+	if gap == 0 {
+		return tolerance > 0 || abutting
+	}
+
+	return gap <= tolerance
+}
+
 func (p Period) Gap(other Period) Period {
 	if p.Overlaps(other) {
 		return Period{boundaryType: IncludeStartExcludeEnd}
@@ -6,6 +6,11 @@ import (
 	"time"
 )
 
+// hour returns the given hour of 2023-01-01 in UTC
+func hour(h int) time.Time {
+	return time.Date(2023, 1, 1, h, 0, 0, 0, time.UTC)
+}
+
 func TestNewPeriod(t *testing.T) {
 	type args struct {
 		startDate    time.Time
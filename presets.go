@@ -0,0 +1,99 @@
+package period
+
+import (
+	"time"
+)
+
+const calendarQuarter = "quarter"
+
+func Today(clock Clock, location *time.Location) Period {
+	return calendarUnit(CalendarDay, nowIn(clock, location), 0, time.Monday)
+}
+
+func ThisWeek(clock Clock, location *time.Location, weekStart time.Weekday) Period {
+	return calendarUnit(CalendarWeek, nowIn(clock, location), 0, weekStart)
+}
+
+// LastNDays returns the n whole days ending with today
+func LastNDays(clock Clock, location *time.Location, n int) Period {
+	now := nowIn(clock, location)
+	if n <= 0 {
+		today := calendarUnit(CalendarDay, now, 0, time.Monday)
+		return today.EndingOn(today.startDate)
+	}
+
+	return calendarSpan(CalendarDay, now, 1-n, 0, time.Monday)
+}
+
+// MonthToDate returns the period from the start of the current month to now
+func MonthToDate(clock Clock, location *time.Location) Period {
+	now := nowIn(clock, location)
+
+	return calendarUnit(CalendarMonth, now, 0, time.Monday).EndingOn(now)
+}
+
+// YearToDate returns the period from the start of the current year to now
+func YearToDate(clock Clock, location *time.Location) Period {
+	now := nowIn(clock, location)
+
+	return calendarUnit(CalendarYear, now, 0, time.Monday).EndingOn(now)
+}
+
+func PreviousQuarter(clock Clock, location *time.Location) Period {
+	return calendarUnit(calendarQuarter, nowIn(clock, location), -1, time.Monday)
+}
+
+// IsPast reports whether the period ended before the current time of the clock
+func (p Period) IsPast(clock Clock) bool {
+	now := nowIn(clock, nil)
+
+	return p.endDate.Before(now) || p.endDate.Equal(now) && !boundaryIsEndIncluded(p.GetBoundaryType())
+}
+
+func (p Period) IsCurrent(clock Clock) bool {
+	return p.containsDatePoint(nowIn(clock, nil), p.GetBoundaryType())
+}
+
+// IsFuture reports whether the period starts after the current time of the clock
+func (p Period) IsFuture(clock Clock) bool {
+	now := nowIn(clock, nil)
+
+	return p.startDate.After(now) || p.startDate.Equal(now) && !boundaryIsStartIncluded(p.GetBoundaryType())
+}
+
+func nowIn(clock Clock, location *time.Location) time.Time {
+	if clock == nil {
+		clock = SystemClock
+	}
+
+	if location == nil {
+		return clock.Now()
+	}
+
+	return clock.Now().In(location)
+}
+
+// calendarUnit returns the unit containing date in its location, moved by offset units
+func calendarUnit(unit string, date time.Time, offset int, weekStart time.Weekday) Period {
+	year, month, day := date.Date()
+	location := date.Location()
+
+	switch unit {
+	case CalendarWeek:
+		start := time.Date(year, month, day-(int(date.Weekday())-int(weekStart)+7)%7+offset*7, 0, 0, 0, 0, location)
+		return NewDefaultPeriod(start, start.AddDate(0, 0, 7))
+	case CalendarMonth:
+		return Period{}.fromMonth(year, int(month)+offset, IncludeStartExcludeEnd, location)
+	case calendarQuarter:
+		return Period{}.fromQuarter(year, (int(month)-1)/3+1+offset, IncludeStartExcludeEnd, location)
+	case CalendarYear:
+		return Period{}.fromYear(year+offset, IncludeStartExcludeEnd, location)
+	default:
+		return Period{}.fromDay(year, int(month), day+offset, IncludeStartExcludeEnd, location)
+	}
+}
+
+// calendarSpan returns the units from the from-th to the to-th around date, both included
+func calendarSpan(unit string, date time.Time, from, to int, weekStart time.Weekday) Period {
+	return NewDefaultPeriod(calendarUnit(unit, date, from, weekStart).startDate, calendarUnit(unit, date, to, weekStart).endDate)
+}
@@ -0,0 +1,98 @@
+package period
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestPresets(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+
+	// Sunday, Nov 5th 2023 01:30 EST, after the DST fall back, still Nov 5th in New York but not in UTC
+	clock := NewFixedClock(time.Date(2023, 11, 5, 6, 30, 0, 0, time.UTC))
+	now := clock.Now().In(newYork)
+
+	date := func(year, month, day int) time.Time {
+		return time.Date(year, time.Month(month), day, 0, 0, 0, 0, newYork)
+	}
+
+	tests := []struct {
+		name string
+		got  Period
+		want Period
+	}{
+		{name: "Today_WithLocation", got: Today(clock, newYork), want: NewDefaultPeriod(date(2023, 11, 5), date(2023, 11, 6))},
+		{name: "Today_WithUTC", got: Today(clock, time.UTC), want: NewDefaultPeriod(time.Date(2023, 11, 5, 0, 0, 0, 0, time.UTC), time.Date(2023, 11, 6, 0, 0, 0, 0, time.UTC))},
+		{name: "ThisWeek_WithMonday", got: ThisWeek(clock, newYork, time.Monday), want: NewDefaultPeriod(date(2023, 10, 30), date(2023, 11, 6))},
+		{name: "ThisWeek_WithSunday", got: ThisWeek(clock, newYork, time.Sunday), want: NewDefaultPeriod(date(2023, 11, 5), date(2023, 11, 12))},
+		{name: "LastNDays_WithSevenDays", got: LastNDays(clock, newYork, 7), want: NewDefaultPeriod(date(2023, 10, 30), date(2023, 11, 6))},
+		{name: "LastNDays_WithZeroDays", got: LastNDays(clock, newYork, 0), want: NewDefaultPeriod(date(2023, 11, 5), date(2023, 11, 5))},
+		{name: "MonthToDate_WithLocation", got: MonthToDate(clock, newYork), want: NewDefaultPeriod(date(2023, 11, 1), now)},
+		{name: "YearToDate_WithLocation", got: YearToDate(clock, newYork), want: NewDefaultPeriod(date(2023, 1, 1), now)},
+		{name: "PreviousQuarter_WithLocation", got: PreviousQuarter(clock, newYork), want: NewDefaultPeriod(date(2023, 7, 1), date(2023, 10, 1))},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.True(t, tt.want.Equals(tt.got), "%v != %v", tt.want, tt.got)
+			},
+		)
+	}
+}
+
+func TestPeriodStatus(t *testing.T) {
+	now := time.Date(2023, 1, 2, 12, 0, 0, 0, time.UTC)
+	clock := NewFixedClock(now)
+
+	tests := []struct {
+		name        string
+		period      Period
+		wantPast    bool
+		wantCurrent bool
+		wantFuture  bool
+	}{
+		{
+			name:     "Status_WithPastPeriod",
+			period:   NewDefaultPeriod(now.Add(-2*time.Hour), now.Add(-time.Hour)),
+			wantPast: true,
+		},
+		{
+			name:     "Status_WithExcludedEndAtNow",
+			period:   NewDefaultPeriod(now.Add(-time.Hour), now),
+			wantPast: true,
+		},
+		{
+			name:        "Status_WithIncludedEndAtNow",
+			period:      NewIncludeAllPeriod(now.Add(-time.Hour), now),
+			wantCurrent: true,
+		},
+		{
+			name:        "Status_WithCurrentPeriod",
+			period:      NewDefaultPeriod(now, now.Add(time.Hour)),
+			wantCurrent: true,
+		},
+		{
+			name:       "Status_WithExcludedStartAtNow",
+			period:     NewPeriod(now, now.Add(time.Hour), ExcludeAll),
+			wantFuture: true,
+		},
+		{
+			name:       "Status_WithFuturePeriod",
+			period:     NewDefaultPeriod(now.Add(time.Hour), now.Add(2*time.Hour)),
+			wantFuture: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.wantPast, tt.period.IsPast(clock))
+				assert.Equal(t, tt.wantCurrent, tt.period.IsCurrent(clock))
+				assert.Equal(t, tt.wantFuture, tt.period.IsFuture(clock))
+			},
+		)
+	}
+}
@@ -0,0 +1,58 @@
+package period
+
+import (
+	"time"
+)
+
+// scale anchors 缩放锚点
+const (
+	AnchorStart  = "start"
+	AnchorEnd    = "end"
+	AnchorCenter = "center"
+)
+
+// Progress returns the elapsed fraction of the period at the date, clamped between 0 and 1
+func (p Period) Progress(date time.Time) float64 {
+	switch {
+	case !date.After(p.startDate):
+		if date.Equal(p.endDate) {
+			return 1
+		}
+		return 0
+	case !date.Before(p.endDate):
+		return 1
+	}
+
+	return float64(date.Sub(p.startDate)) / float64(p.endDate.Sub(p.startDate))
+}
+
+// At returns the date at the given fraction of the period, the fraction is clamped between 0 and 1
+func (p Period) At(fraction float64) time.Time {
+	switch {
+	case fraction <= 0:
+		return p.startDate
+	case fraction >= 1:
+		return p.endDate
+	}
+
+	return p.startDate.Add(time.Duration(fraction * float64(p.endDate.Sub(p.startDate))))
+}
+
+func (p Period) Midpoint() time.Time {
+	return p.startDate.Add(p.endDate.Sub(p.startDate) / 2)
+}
+
+// Scale multiplies the duration by factor around the anchor, the bounds are kept and a negative factor is treated as 0
+func (p Period) Scale(factor float64, anchor string) Period {
+	duration := time.Duration(max(factor, 0) * float64(p.endDate.Sub(p.startDate)))
+
+	switch anchor {
+	case AnchorEnd:
+		return p.StartingOn(p.endDate.Add(-duration))
+	case AnchorCenter:
+		startDate := p.Midpoint().Add(-duration / 2)
+		return p.StartingOn(startDate).EndingOn(startDate.Add(duration))
+	default:
+		return p.EndingOn(p.startDate.Add(duration))
+	}
+}
@@ -0,0 +1,85 @@
+package period
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestPeriodProgress(t *testing.T) {
+	period := NewPeriod(hour(2), hour(6), ExcludeAll)
+
+	tests := []struct {
+		name   string
+		period Period
+		date   time.Time
+		want   float64
+	}{
+		{name: "Progress_WithDateBefore", period: period, date: hour(0), want: 0},
+		{name: "Progress_WithStartDate", period: period, date: hour(2), want: 0},
+		{name: "Progress_WithQuarter", period: period, date: hour(3), want: 0.25},
+		{name: "Progress_WithEndDate", period: period, date: hour(6), want: 1},
+		{name: "Progress_WithDateAfter", period: period, date: hour(9), want: 1},
+		{name: "Progress_WithInstantBefore", period: NewIncludeAllPeriod(hour(2), hour(2)), date: hour(1), want: 0},
+		{name: "Progress_WithInstantReached", period: NewIncludeAllPeriod(hour(2), hour(2)), date: hour(2), want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.InDelta(t, tt.want, tt.period.Progress(tt.date), 1e-9)
+			},
+		)
+	}
+}
+
+func TestPeriodAt(t *testing.T) {
+	period := NewDefaultPeriod(hour(2), hour(6))
+
+	tests := []struct {
+		name     string
+		fraction float64
+		want     time.Time
+	}{
+		{name: "At_WithNegativeFraction", fraction: -1, want: hour(2)},
+		{name: "At_WithQuarter", fraction: 0.25, want: hour(3)},
+		{name: "At_WithWholePeriod", fraction: 1, want: hour(6)},
+		{name: "At_WithFractionAboveOne", fraction: 2, want: hour(6)},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.want, period.At(tt.fraction))
+			},
+		)
+	}
+
+	assert.Equal(t, hour(4), period.Midpoint())
+}
+
+func TestPeriodScale(t *testing.T) {
+	period := NewPeriod(hour(2), hour(6), ExcludeStartIncludeEnd)
+
+	tests := []struct {
+		name   string
+		factor float64
+		anchor string
+		want   Period
+	}{
+		{name: "Scale_WithStartAnchor", factor: 2, anchor: AnchorStart, want: NewPeriod(hour(2), hour(10), ExcludeStartIncludeEnd)},
+		{name: "Scale_WithEndAnchor", factor: 0.5, anchor: AnchorEnd, want: NewPeriod(hour(4), hour(6), ExcludeStartIncludeEnd)},
+		{name: "Scale_WithCenterAnchor", factor: 1.5, anchor: AnchorCenter, want: NewPeriod(hour(1), hour(7), ExcludeStartIncludeEnd)},
+		{name: "Scale_WithNegativeFactor", factor: -1, anchor: AnchorCenter, want: NewPeriod(hour(4), hour(4), ExcludeStartIncludeEnd)},
+		{name: "Scale_WithUnknownAnchor", factor: 0.5, anchor: "middle", want: NewPeriod(hour(2), hour(4), ExcludeStartIncludeEnd)},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got := period.Scale(tt.factor, tt.anchor)
+				assert.True(t, tt.want.Equals(got), "%v != %v", tt.want, got)
+			},
+		)
+	}
+}
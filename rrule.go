@@ -0,0 +1,873 @@
+package period
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// frequencies 重复频率
+const (
+	Yearly   = "YEARLY"
+	Monthly  = "MONTHLY"
+	Weekly   = "WEEKLY"
+	Daily    = "DAILY"
+	Hourly   = "HOURLY"
+	Minutely = "MINUTELY"
+)
+
+var frequencies = map[string]int{
+	Yearly:   1,
+	Monthly:  1,
+	Weekly:   1,
+	Daily:    1,
+	Hourly:   1,
+	Minutely: 1,
+}
+
+var weekdayCodes = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// maxEmptyYears stops the expansion of rules which can never match, e.g. FREQ=MONTHLY;BYMONTHDAY=30;BYMONTH=2,
+// the Gregorian calendar repeats every 400 years so a rule without any occurrence over that span has none at all
+const maxEmptyYears = 400
+
+var ErrInvalidRRule = errors.New("period: invalid recurrence rule")
+
+type weekdayNum struct {
+	weekday time.Weekday
+	n       int
+}
+
+type RRule struct {
+	freq          string
+	interval      int
+	count         int
+	until         time.Time
+	untilFloating bool
+	byMonth       []int
+	byMonthDay    []int
+	byDay         []weekdayNum
+	byHour        []int
+	byMinute      []int
+	bySecond      []int
+	bySetPos      []int
+	weekStart     time.Weekday
+	dtStart       time.Time
+	duration      time.Duration
+	boundaryType  string
+	rDates        []time.Time
+	exDates       []time.Time
+}
+
+// ParseRRule parses either a bare rule (FREQ=WEEKLY;BYDAY=MO) or content lines made of DTSTART, RRULE, RDATE and EXDATE
+func ParseRRule(rule string) (RRule, error) {
+	r := RRule{interval: 1, weekStart: time.Monday, boundaryType: IncludeStartExcludeEnd}
+	lines := strings.FieldsFunc(
+		rule, func(c rune) bool {
+			return c == '\n' || c == '\r'
+		},
+	)
+
+	hasRule := false
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		name, params, value := splitContentLine(line)
+		if !strings.Contains(line, ":") {
+			name, params, value = "RRULE", nil, line
+		}
+
+		var err error
+		switch name {
+		case "RRULE":
+			hasRule = true
+			err = r.parseRule(value)
+		case "DTSTART":
+			r.dtStart, _, err = parseICalTime(value, params, time.UTC)
+		case "RDATE":
+			var dates []time.Time
+			dates, err = parseICalTimes(value, params)
+			r.rDates = append(r.rDates, dates...)
+		case "EXDATE":
+			var dates []time.Time
+			dates, err = parseICalTimes(value, params)
+			r.exDates = append(r.exDates, dates...)
+		default:
+			err = fmt.Errorf("%w: unsupported property %q", ErrInvalidRRule, name)
+		}
+
+		if err != nil {
+			if !errors.Is(err, ErrInvalidRRule) {
+				err = fmt.Errorf("%w: %s: %v", ErrInvalidRRule, name, err)
+			}
+			return RRule{}, err
+		}
+	}
+
+	if !hasRule {
+		return RRule{}, fmt.Errorf("%w: missing RRULE", ErrInvalidRRule)
+	}
+
+	return r, nil
+}
+
+func (r *RRule) parseRule(value string) error {
+	for _, part := range strings.Split(value, ";") {
+		if part == "" {
+			continue
+		}
+
+		key, val, ok := strings.Cut(part, "=")
+		if !ok {
+			return fmt.Errorf("%w: malformed part %q", ErrInvalidRRule, part)
+		}
+
+		var err error
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			r.freq = strings.ToUpper(val)
+			if _, ok := frequencies[r.freq]; !ok {
+				err = fmt.Errorf("%w: unsupported FREQ %q", ErrInvalidRRule, val)
+			}
+		case "INTERVAL":
+			r.interval, err = strconv.Atoi(val)
+			if err == nil && r.interval < 1 {
+				err = fmt.Errorf("%w: INTERVAL must be positive", ErrInvalidRRule)
+			}
+		case "COUNT":
+			r.count, err = strconv.Atoi(val)
+			if err == nil && r.count < 1 {
+				err = fmt.Errorf("%w: COUNT must be positive", ErrInvalidRRule)
+			}
+		case "UNTIL":
+			err = r.parseUntil(val)
+		case "BYMONTH":
+			r.byMonth, err = parseRRuleInts(val, 1, 12, false)
+		case "BYMONTHDAY":
+			r.byMonthDay, err = parseRRuleInts(val, 1, 31, true)
+		case "BYHOUR":
+			r.byHour, err = parseRRuleInts(val, 0, 23, false)
+		case "BYMINUTE":
+			r.byMinute, err = parseRRuleInts(val, 0, 59, false)
+		case "BYSECOND":
+			r.bySecond, err = parseRRuleInts(val, 0, 59, false)
+		case "BYSETPOS":
+			r.bySetPos, err = parseRRuleInts(val, 1, 366, true)
+		case "BYDAY":
+			r.byDay, err = parseRRuleWeekdays(val)
+		case "WKST":
+			weekday, ok := weekdayCodes[strings.ToUpper(val)]
+			if !ok {
+				err = fmt.Errorf("%w: invalid WKST %q", ErrInvalidRRule, val)
+			}
+			r.weekStart = weekday
+		default:
+			err = fmt.Errorf("%w: unsupported part %q", ErrInvalidRRule, key)
+		}
+
+		if err != nil {
+			if !errors.Is(err, ErrInvalidRRule) {
+				err = fmt.Errorf("%w: %s: %v", ErrInvalidRRule, key, err)
+			}
+			return err
+		}
+	}
+
+	if r.freq == "" {
+		return fmt.Errorf("%w: missing FREQ", ErrInvalidRRule)
+	}
+
+	if r.count > 0 && !r.until.IsZero() {
+		return fmt.Errorf("%w: COUNT and UNTIL are mutually exclusive", ErrInvalidRRule)
+	}
+
+	if r.freq != Monthly && r.freq != Yearly {
+		for _, day := range r.byDay {
+			if day.n != 0 {
+				return fmt.Errorf("%w: ordinal BYDAY requires FREQ=MONTHLY or FREQ=YEARLY", ErrInvalidRRule)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *RRule) parseUntil(value string) error {
+	until, floating, err := parseICalTime(value, nil, time.UTC)
+	if err != nil {
+		return err
+	}
+
+	// a date only UNTIL includes every occurrence of that day
+	if len(value) == len(iCalDateLayout) {
+		until = until.AddDate(0, 0, 1).Add(-time.Nanosecond)
+	}
+
+	r.until = until
+	r.untilFloating = floating
+
+	return nil
+}
+
+func parseRRuleInts(value string, lower, upper int, signed bool) ([]int, error) {
+	var values []int
+
+	for _, item := range strings.Split(value, ",") {
+		number, err := strconv.Atoi(item)
+		if err != nil {
+			return nil, err
+		}
+
+		absolute := number
+		if signed && number < 0 {
+			absolute = -number
+		}
+
+		if absolute < lower || absolute > upper {
+			return nil, fmt.Errorf("%w: %d is out of range", ErrInvalidRRule, number)
+		}
+
+		values = append(values, number)
+	}
+
+	return values, nil
+}
+
+func parseRRuleWeekdays(value string) ([]weekdayNum, error) {
+	var days []weekdayNum
+
+	for _, item := range strings.Split(strings.ToUpper(value), ",") {
+		if len(item) < 2 {
+			return nil, fmt.Errorf("%w: invalid BYDAY %q", ErrInvalidRRule, item)
+		}
+
+		weekday, ok := weekdayCodes[item[len(item)-2:]]
+		if !ok {
+			return nil, fmt.Errorf("%w: invalid BYDAY %q", ErrInvalidRRule, item)
+		}
+
+		n := 0
+		if prefix := item[:len(item)-2]; prefix != "" {
+			var err error
+			if n, err = strconv.Atoi(prefix); err != nil || n == 0 || n > 53 || n < -53 {
+				return nil, fmt.Errorf("%w: invalid BYDAY %q", ErrInvalidRRule, item)
+			}
+		}
+
+		days = append(days, weekdayNum{weekday: weekday, n: n})
+	}
+
+	return days, nil
+}
+
+func (r RRule) WithStart(dtStart time.Time) RRule {
+	r.dtStart = dtStart
+
+	return r
+}
+
+func (r RRule) WithDuration(duration time.Duration) RRule {
+	r.duration = duration
+
+	return r
+}
+
+func (r RRule) WithBoundaryType(boundaryType string) RRule {
+	if _, ok := boundaryTypes[boundaryType]; ok {
+		r.boundaryType = boundaryType
+	}
+
+	return r
+}
+
+func (r RRule) WithRDates(dates ...time.Time) RRule {
+	r.rDates = append(append([]time.Time{}, r.rDates...), dates...)
+
+	return r
+}
+
+func (r RRule) WithExDates(dates ...time.Time) RRule {
+	r.exDates = append(append([]time.Time{}, r.exDates...), dates...)
+
+	return r
+}
+
+func (r RRule) GetStartDate() time.Time {
+	return r.dtStart
+}
+
+func (r RRule) GetDuration() time.Duration {
+	return r.duration
+}
+
+func (r RRule) isExcluded(date time.Time) bool {
+	for _, exDate := range r.exDates {
+		if exDate.Equal(date) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r RRule) untilDate() time.Time {
+	if !r.untilFloating {
+		return r.until
+	}
+
+	return time.Date(
+		r.until.Year(), r.until.Month(), r.until.Day(),
+		r.until.Hour(), r.until.Minute(), r.until.Second(), r.until.Nanosecond(),
+		r.dtStart.Location(),
+	)
+}
+
+// iterate calls callback with every occurrence generated from the given step in ascending order, until it returns
+// false or the steps start after end
+func (r RRule) iterate(from int, end time.Time, callback func(time.Time) bool) {
+	until := r.untilDate()
+	total := 0
+	matched := r.stepStart(from)
+
+	for step := from; ; step++ {
+		start := r.stepStart(step)
+		if start.After(end) {
+			return
+		}
+
+		occurrences := r.occurrences(step)
+		if len(occurrences) == 0 {
+			if start.After(matched.AddDate(maxEmptyYears, 0, 0)) {
+				return
+			}
+			continue
+		}
+		matched = start
+
+		for _, occurrence := range occurrences {
+			if occurrence.Before(r.dtStart) {
+				continue
+			}
+
+			if !r.until.IsZero() && occurrence.After(until) {
+				return
+			}
+
+			total++
+			if !callback(occurrence) {
+				return
+			}
+
+			if r.count > 0 && total >= r.count {
+				return
+			}
+		}
+	}
+}
+
+// stepStart returns the beginning of the year, month, week, day, hour or minute covered by the step
+func (r RRule) stepStart(step int) time.Time {
+	start := r.dtStart
+	location := start.Location()
+
+	switch r.freq {
+	case Yearly:
+		return time.Date(start.Year()+step*r.interval, time.January, 1, 0, 0, 0, 0, location)
+	case Monthly:
+		return time.Date(start.Year(), start.Month()+time.Month(step*r.interval), 1, 0, 0, 0, 0, location)
+	case Weekly:
+		offset := (int(start.Weekday()) - int(r.weekStart) + 7) % 7
+		return time.Date(start.Year(), start.Month(), start.Day()+step*r.interval*7-offset, 0, 0, 0, 0, location)
+	case Hourly:
+		return time.Date(start.Year(), start.Month(), start.Day(), start.Hour(), 0, 0, 0, location).
+			Add(time.Duration(step*r.interval) * time.Hour)
+	case Minutely:
+		return time.Date(start.Year(), start.Month(), start.Day(), start.Hour(), start.Minute(), 0, 0, location).
+			Add(time.Duration(step*r.interval) * time.Minute)
+	default:
+		return time.Date(start.Year(), start.Month(), start.Day()+step*r.interval, 0, 0, 0, 0, location)
+	}
+}
+
+// firstStep returns a step starting before date for the fixed length frequencies, so that a query does not walk
+// from DTSTART, COUNT needs every occurrence from the first one
+func (r RRule) firstStep(date time.Time) int {
+	if r.count > 0 || !date.After(r.dtStart) {
+		return 0
+	}
+
+	var step int
+	switch r.freq {
+	case Daily, Weekly:
+		local := date.In(r.dtStart.Location())
+		first := time.Date(r.dtStart.Year(), r.dtStart.Month(), r.dtStart.Day(), 0, 0, 0, 0, time.UTC)
+		days := int(time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC).Sub(first) / (24 * time.Hour))
+
+		step = days / r.interval
+		if r.freq == Weekly {
+			step /= 7
+		}
+	case Hourly:
+		step = int(date.Sub(r.dtStart) / (time.Duration(r.interval) * time.Hour))
+	case Minutely:
+		step = int(date.Sub(r.dtStart) / (time.Duration(r.interval) * time.Minute))
+	}
+
+	return max(step-1, 0)
+}
+
+func (r RRule) occurrences(step int) []time.Time {
+	var occurrences []time.Time
+
+	switch r.freq {
+	case Hourly:
+		occurrences = r.hourlyOccurrences(step)
+	case Minutely:
+		occurrences = r.minutelyOccurrences(step)
+	default:
+		occurrences = r.combineTimes(r.dates(step))
+	}
+
+	sort.Slice(
+		occurrences, func(i, j int) bool {
+			return occurrences[i].Before(occurrences[j])
+		},
+	)
+
+	var unique []time.Time
+	for _, occurrence := range occurrences {
+		if len(unique) == 0 || !unique[len(unique)-1].Equal(occurrence) {
+			unique = append(unique, occurrence)
+		}
+	}
+
+	return r.applySetPos(unique)
+}
+
+func (r RRule) applySetPos(occurrences []time.Time) []time.Time {
+	if len(r.bySetPos) == 0 || len(occurrences) == 0 {
+		return occurrences
+	}
+
+	var selected []time.Time
+	for _, pos := range r.bySetPos {
+		index := pos - 1
+		if pos < 0 {
+			index = len(occurrences) + pos
+		}
+
+		if index >= 0 && index < len(occurrences) {
+			selected = append(selected, occurrences[index])
+		}
+	}
+
+	sort.Slice(
+		selected, func(i, j int) bool {
+			return selected[i].Before(selected[j])
+		},
+	)
+
+	return selected
+}
+
+// dates returns the days (as UTC midnights) generated by a daily or coarser frequency for the given step
+func (r RRule) dates(step int) []time.Time {
+	start := r.dtStart
+	day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch r.freq {
+	case Yearly:
+		year := start.Year() + step*r.interval
+
+		if len(r.byMonth) == 0 && len(r.byMonthDay) == 0 && len(r.byDay) > 0 {
+			return r.weekdaysIn(time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(year, 12, 31, 0, 0, 0, 0, time.UTC))
+		}
+
+		months := r.byMonth
+		if len(months) == 0 && len(r.byMonthDay) == 0 {
+			months = []int{int(start.Month())}
+		}
+		if len(months) == 0 {
+			months = []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}
+		}
+
+		var dates []time.Time
+		for _, month := range months {
+			dates = append(dates, r.monthDates(year, time.Month(month))...)
+		}
+
+		return dates
+	case Monthly:
+		months := int(start.Month()) - 1 + step*r.interval
+		year, month := start.Year()+months/12, time.Month(months%12+1)
+
+		if len(r.byMonth) > 0 && !containsInt(r.byMonth, int(month)) {
+			return nil
+		}
+
+		return r.monthDates(year, month)
+	case Weekly:
+		offset := (int(day.Weekday()) - int(r.weekStart) + 7) % 7
+		weekStart := day.AddDate(0, 0, step*r.interval*7-offset)
+
+		var dates []time.Time
+		for i := 0; i < 7; i++ {
+			date := weekStart.AddDate(0, 0, i)
+
+			if len(r.byDay) == 0 && date.Weekday() != start.Weekday() {
+				continue
+			}
+
+			if r.matchesDate(date) {
+				dates = append(dates, date)
+			}
+		}
+
+		return dates
+	default:
+		date := day.AddDate(0, 0, step*r.interval)
+		if r.matchesDate(date) {
+			return []time.Time{date}
+		}
+
+		return nil
+	}
+}
+
+func (r RRule) monthDates(year int, month time.Month) []time.Time {
+	lastDay := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+
+	if len(r.byMonthDay) > 0 {
+		var dates []time.Time
+
+		for _, monthDay := range r.byMonthDay {
+			if monthDay < 0 {
+				monthDay = lastDay + monthDay + 1
+			}
+
+			if monthDay < 1 || monthDay > lastDay {
+				continue
+			}
+
+			date := time.Date(year, month, monthDay, 0, 0, 0, 0, time.UTC)
+			if r.matchesWeekday(date) {
+				dates = append(dates, date)
+			}
+		}
+
+		return dates
+	}
+
+	if len(r.byDay) > 0 {
+		return r.weekdaysIn(time.Date(year, month, 1, 0, 0, 0, 0, time.UTC), time.Date(year, month, lastDay, 0, 0, 0, 0, time.UTC))
+	}
+
+	if r.dtStart.Day() > lastDay {
+		return nil
+	}
+
+	return []time.Time{time.Date(year, month, r.dtStart.Day(), 0, 0, 0, 0, time.UTC)}
+}
+
+// weekdaysIn resolves BYDAY inside [first, last], ordinals are relative to that range
+func (r RRule) weekdaysIn(first, last time.Time) []time.Time {
+	var dates []time.Time
+
+	for _, day := range r.byDay {
+		var matches []time.Time
+
+		offset := (int(day.weekday) - int(first.Weekday()) + 7) % 7
+		for date := first.AddDate(0, 0, offset); !date.After(last); date = date.AddDate(0, 0, 7) {
+			matches = append(matches, date)
+		}
+
+		switch {
+		case day.n == 0:
+			dates = append(dates, matches...)
+		case day.n > 0 && day.n <= len(matches):
+			dates = append(dates, matches[day.n-1])
+		case day.n < 0 && -day.n <= len(matches):
+			dates = append(dates, matches[len(matches)+day.n])
+		}
+	}
+
+	return dates
+}
+
+func (r RRule) matchesWeekday(date time.Time) bool {
+	if len(r.byDay) == 0 {
+		return true
+	}
+
+	for _, day := range r.byDay {
+		if day.weekday == date.Weekday() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r RRule) matchesDate(date time.Time) bool {
+	if len(r.byMonth) > 0 && !containsInt(r.byMonth, int(date.Month())) {
+		return false
+	}
+
+	if len(r.byMonthDay) > 0 {
+		lastDay := time.Date(date.Year(), date.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+		matched := false
+
+		for _, monthDay := range r.byMonthDay {
+			if monthDay == date.Day() || monthDay < 0 && lastDay+monthDay+1 == date.Day() {
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			return false
+		}
+	}
+
+	return r.matchesWeekday(date)
+}
+
+func (r RRule) combineTimes(dates []time.Time) []time.Time {
+	hours := r.byHour
+	if len(hours) == 0 {
+		hours = []int{r.dtStart.Hour()}
+	}
+
+	minutes := r.byMinute
+	if len(minutes) == 0 {
+		minutes = []int{r.dtStart.Minute()}
+	}
+
+	seconds := r.bySecond
+	if len(seconds) == 0 {
+		seconds = []int{r.dtStart.Second()}
+	}
+
+	var occurrences []time.Time
+	for _, date := range dates {
+		for _, hour := range hours {
+			for _, minute := range minutes {
+				for _, second := range seconds {
+					occurrences = append(
+						occurrences, time.Date(
+							date.Year(), date.Month(), date.Day(), hour, minute, second, r.dtStart.Nanosecond(),
+							r.dtStart.Location(),
+						),
+					)
+				}
+			}
+		}
+	}
+
+	return occurrences
+}
+
+func (r RRule) hourlyOccurrences(step int) []time.Time {
+	start := r.dtStart
+	base := time.Date(start.Year(), start.Month(), start.Day(), start.Hour(), 0, 0, 0, start.Location()).
+		Add(time.Duration(step*r.interval) * time.Hour)
+
+	if !r.matchesDate(time.Date(base.Year(), base.Month(), base.Day(), 0, 0, 0, 0, time.UTC)) {
+		return nil
+	}
+
+	if len(r.byHour) > 0 && !containsInt(r.byHour, base.Hour()) {
+		return nil
+	}
+
+	minutes := r.byMinute
+	if len(minutes) == 0 {
+		minutes = []int{start.Minute()}
+	}
+
+	seconds := r.bySecond
+	if len(seconds) == 0 {
+		seconds = []int{start.Second()}
+	}
+
+	var occurrences []time.Time
+	for _, minute := range minutes {
+		for _, second := range seconds {
+			occurrences = append(
+				occurrences,
+				base.Add(time.Duration(minute)*time.Minute+time.Duration(second)*time.Second+time.Duration(start.Nanosecond())),
+			)
+		}
+	}
+
+	return occurrences
+}
+
+func (r RRule) minutelyOccurrences(step int) []time.Time {
+	start := r.dtStart
+	base := time.Date(start.Year(), start.Month(), start.Day(), start.Hour(), start.Minute(), 0, 0, start.Location()).
+		Add(time.Duration(step*r.interval) * time.Minute)
+
+	if !r.matchesDate(time.Date(base.Year(), base.Month(), base.Day(), 0, 0, 0, 0, time.UTC)) {
+		return nil
+	}
+
+	if len(r.byHour) > 0 && !containsInt(r.byHour, base.Hour()) {
+		return nil
+	}
+
+	if len(r.byMinute) > 0 && !containsInt(r.byMinute, base.Minute()) {
+		return nil
+	}
+
+	seconds := r.bySecond
+	if len(seconds) == 0 {
+		seconds = []int{start.Second()}
+	}
+
+	var occurrences []time.Time
+	for _, second := range seconds {
+		occurrences = append(occurrences, base.Add(time.Duration(second)*time.Second+time.Duration(start.Nanosecond())))
+	}
+
+	return occurrences
+}
+
+// Between returns the start dates of the recurrence set (RDATE included, EXDATE removed) inside [startDate, endDate], nothing without DTSTART
+func (r RRule) Between(startDate, endDate time.Time) []time.Time {
+	if r.dtStart.IsZero() {
+		return nil
+	}
+
+	var dates []time.Time
+
+	r.iterate(
+		r.firstStep(startDate), endDate, func(occurrence time.Time) bool {
+			if occurrence.After(endDate) {
+				return false
+			}
+
+			if !occurrence.Before(startDate) && !r.isExcluded(occurrence) {
+				dates = append(dates, occurrence)
+			}
+
+			return true
+		},
+	)
+
+	for _, rDate := range r.rDates {
+		if !rDate.Before(startDate) && !rDate.After(endDate) && !r.isExcluded(rDate) {
+			dates = append(dates, rDate)
+		}
+	}
+
+	sort.Slice(
+		dates, func(i, j int) bool {
+			return dates[i].Before(dates[j])
+		},
+	)
+
+	var unique []time.Time
+	for _, date := range dates {
+		if len(unique) == 0 || !unique[len(unique)-1].Equal(date) {
+			unique = append(unique, date)
+		}
+	}
+
+	return unique
+}
+
+func (r RRule) Expand(window Period) Sequence {
+	sequence := Sequence{}
+
+	for _, date := range r.Between(window.startDate.Add(-r.duration), window.endDate) {
+		period := NewPeriod(date, date.Add(r.duration), r.boundaryType)
+
+		if window.Overlaps(period) || r.duration == 0 && window.containsDatePoint(date, window.GetBoundaryType()) {
+			sequence = sequence.Push(period)
+		}
+	}
+
+	return sequence
+}
+
+func containsInt(values []int, value int) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+const (
+	iCalDateLayout     = "20060102"
+	iCalDateTimeLayout = "20060102T150405"
+)
+
+// splitContentLine splits an iCalendar content line "NAME;PARAM=VALUE:value" into its parts
+func splitContentLine(line string) (string, map[string]string, string) {
+	head, value, _ := strings.Cut(line, ":")
+	parts := strings.Split(head, ";")
+	params := map[string]string{}
+
+	for _, part := range parts[1:] {
+		key, val, _ := strings.Cut(part, "=")
+		params[strings.ToUpper(key)] = strings.Trim(val, `"`)
+	}
+
+	return strings.ToUpper(parts[0]), params, value
+}
+
+// parseICalTime parses DATE and DATE-TIME values, floating values are read in the TZID location or loc
+func parseICalTime(value string, params map[string]string, loc *time.Location) (time.Time, bool, error) {
+	if tzid, ok := params["TZID"]; ok {
+		location, err := time.LoadLocation(tzid)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("%w: unknown TZID %q", ErrInvalidRRule, tzid)
+		}
+		loc = location
+	}
+
+	switch {
+	case strings.HasSuffix(value, "Z"):
+		date, err := time.Parse(iCalDateTimeLayout, strings.TrimSuffix(value, "Z"))
+		return date, false, err
+	case len(value) == len(iCalDateLayout):
+		date, err := time.ParseInLocation(iCalDateLayout, value, loc)
+		return date, true, err
+	default:
+		date, err := time.ParseInLocation(iCalDateTimeLayout, value, loc)
+		return date, true, err
+	}
+}
+
+func parseICalTimes(value string, params map[string]string) ([]time.Time, error) {
+	var dates []time.Time
+
+	for _, item := range strings.Split(value, ",") {
+		date, _, err := parseICalTime(item, params, time.UTC)
+		if err != nil {
+			return nil, err
+		}
+
+		dates = append(dates, date)
+	}
+
+	return dates, nil
+}
@@ -0,0 +1,442 @@
+package period
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestParseRRule(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    string
+		wantErr bool
+	}{
+		{
+			name: "ParseRRule_WithBareRule",
+			rule: "FREQ=WEEKLY;BYDAY=MO,WE;UNTIL=20240131T000000Z",
+		},
+		{
+			name: "ParseRRule_WithPrefixedRule",
+			rule: "RRULE:FREQ=MONTHLY;BYDAY=-1FR;COUNT=3",
+		},
+		{
+			name: "ParseRRule_WithContentLines",
+			rule: "DTSTART;TZID=America/New_York:20240101T090000\nRRULE:FREQ=DAILY\nEXDATE;TZID=America/New_York:20240102T090000",
+		},
+		{
+			name:    "ParseRRule_WithoutFreq",
+			rule:    "BYDAY=MO",
+			wantErr: true,
+		},
+		{
+			name:    "ParseRRule_WithUnsupportedFreq",
+			rule:    "FREQ=SECONDLY",
+			wantErr: true,
+		},
+		{
+			name:    "ParseRRule_WithCountAndUntil",
+			rule:    "FREQ=DAILY;COUNT=2;UNTIL=20240101",
+			wantErr: true,
+		},
+		{
+			name:    "ParseRRule_WithInvalidInterval",
+			rule:    "FREQ=DAILY;INTERVAL=0",
+			wantErr: true,
+		},
+		{
+			name:    "ParseRRule_WithOutOfRangeMonth",
+			rule:    "FREQ=YEARLY;BYMONTH=13",
+			wantErr: true,
+		},
+		{
+			name:    "ParseRRule_WithInvalidWeekday",
+			rule:    "FREQ=WEEKLY;BYDAY=XX",
+			wantErr: true,
+		},
+		{
+			name:    "ParseRRule_WithOrdinalWeekdayOnWeeklyRule",
+			rule:    "FREQ=WEEKLY;BYDAY=1MO",
+			wantErr: true,
+		},
+		{
+			name:    "ParseRRule_WithUnsupportedPart",
+			rule:    "FREQ=YEARLY;BYWEEKNO=20",
+			wantErr: true,
+		},
+		{
+			name:    "ParseRRule_WithUnknownTimezone",
+			rule:    "DTSTART;TZID=Mars/Olympus:20240101T090000\nRRULE:FREQ=DAILY",
+			wantErr: true,
+		},
+		{
+			name:    "ParseRRule_WithInvalidDate",
+			rule:    "DTSTART:2024-01-01\nRRULE:FREQ=DAILY",
+			wantErr: true,
+		},
+		{
+			name:    "ParseRRule_WithoutRule",
+			rule:    "DTSTART:20240101T090000Z",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				_, err := ParseRRule(tt.rule)
+				if tt.wantErr {
+					assert.ErrorIs(t, err, ErrInvalidRRule)
+				} else {
+					assert.NoError(t, err)
+				}
+			},
+		)
+	}
+}
+
+func TestRRuleBetween(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+
+	date := func(year, month, day, hour int) time.Time {
+		return time.Date(year, time.Month(month), day, hour, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name  string
+		rule  string
+		start time.Time
+		from  time.Time
+		to    time.Time
+		want  []time.Time
+	}{
+		{
+			name:  "Between_WithWeeklyByDay",
+			rule:  "FREQ=WEEKLY;BYDAY=MO,WE",
+			start: date(2024, 1, 1, 9),
+			from:  date(2024, 1, 1, 0),
+			to:    date(2024, 1, 14, 0),
+			want:  []time.Time{date(2024, 1, 1, 9), date(2024, 1, 3, 9), date(2024, 1, 8, 9), date(2024, 1, 10, 9)},
+		},
+		{
+			name:  "Between_WithWeeklyInterval",
+			rule:  "FREQ=WEEKLY;INTERVAL=2;BYDAY=TU",
+			start: date(2024, 1, 1, 9),
+			from:  date(2024, 1, 1, 0),
+			to:    date(2024, 1, 31, 0),
+			want:  []time.Time{date(2024, 1, 2, 9), date(2024, 1, 16, 9), date(2024, 1, 30, 9)},
+		},
+		{
+			name:  "Between_WithUntil",
+			rule:  "FREQ=DAILY;UNTIL=20240103T090000Z",
+			start: date(2024, 1, 1, 9),
+			from:  date(2024, 1, 1, 0),
+			to:    date(2024, 1, 31, 0),
+			want:  []time.Time{date(2024, 1, 1, 9), date(2024, 1, 2, 9), date(2024, 1, 3, 9)},
+		},
+		{
+			name:  "Between_WithDateUntil",
+			rule:  "FREQ=DAILY;UNTIL=20240102",
+			start: date(2024, 1, 1, 9),
+			from:  date(2024, 1, 1, 0),
+			to:    date(2024, 1, 31, 0),
+			want:  []time.Time{date(2024, 1, 1, 9), date(2024, 1, 2, 9)},
+		},
+		{
+			name:  "Between_WithCountBeforeWindow",
+			rule:  "FREQ=DAILY;COUNT=3",
+			start: date(2024, 1, 1, 9),
+			from:  date(2024, 1, 2, 0),
+			to:    date(2024, 1, 31, 0),
+			want:  []time.Time{date(2024, 1, 2, 9), date(2024, 1, 3, 9)},
+		},
+		{
+			name:  "Between_WithLastFridayOfMonth",
+			rule:  "FREQ=MONTHLY;BYDAY=-1FR;COUNT=3",
+			start: date(2024, 1, 1, 9),
+			from:  date(2024, 1, 1, 0),
+			to:    date(2024, 12, 31, 0),
+			want:  []time.Time{date(2024, 1, 26, 9), date(2024, 2, 23, 9), date(2024, 3, 29, 9)},
+		},
+		{
+			name:  "Between_WithLastWorkdayOfMonth",
+			rule:  "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1;COUNT=3",
+			start: date(2024, 1, 1, 9),
+			from:  date(2024, 1, 1, 0),
+			to:    date(2024, 12, 31, 0),
+			want:  []time.Time{date(2024, 1, 31, 9), date(2024, 2, 29, 9), date(2024, 3, 29, 9)},
+		},
+		{
+			name:  "Between_WithNegativeMonthDay",
+			rule:  "FREQ=MONTHLY;BYMONTHDAY=-1;COUNT=2",
+			start: date(2024, 1, 1, 9),
+			from:  date(2024, 1, 1, 0),
+			to:    date(2024, 12, 31, 0),
+			want:  []time.Time{date(2024, 1, 31, 9), date(2024, 2, 29, 9)},
+		},
+		{
+			name:  "Between_WithMissingMonthDay",
+			rule:  "FREQ=MONTHLY;COUNT=3",
+			start: date(2024, 1, 31, 9),
+			from:  date(2024, 1, 1, 0),
+			to:    date(2024, 12, 31, 0),
+			want:  []time.Time{date(2024, 1, 31, 9), date(2024, 3, 31, 9), date(2024, 5, 31, 9)},
+		},
+		{
+			name:  "Between_WithYearlyByMonthAndDay",
+			rule:  "FREQ=YEARLY;BYMONTH=11;BYDAY=4TH;COUNT=2",
+			start: date(2024, 1, 1, 12),
+			from:  date(2024, 1, 1, 0),
+			to:    date(2030, 1, 1, 0),
+			want:  []time.Time{date(2024, 11, 28, 12), date(2025, 11, 27, 12)},
+		},
+		{
+			name:  "Between_WithYearlyByDay",
+			rule:  "FREQ=YEARLY;BYDAY=1MO;COUNT=2",
+			start: date(2024, 1, 1, 12),
+			from:  date(2024, 1, 1, 0),
+			to:    date(2030, 1, 1, 0),
+			want:  []time.Time{date(2024, 1, 1, 12), date(2025, 1, 6, 12)},
+		},
+		{
+			name:  "Between_WithYearlyDefaultDay",
+			rule:  "FREQ=YEARLY;COUNT=2",
+			start: date(2024, 3, 5, 12),
+			from:  date(2024, 1, 1, 0),
+			to:    date(2030, 1, 1, 0),
+			want:  []time.Time{date(2024, 3, 5, 12), date(2025, 3, 5, 12)},
+		},
+		{
+			name:  "Between_WithDailyByHour",
+			rule:  "FREQ=DAILY;BYHOUR=9,17;COUNT=3",
+			start: date(2024, 1, 1, 9),
+			from:  date(2024, 1, 1, 0),
+			to:    date(2024, 1, 31, 0),
+			want:  []time.Time{date(2024, 1, 1, 9), date(2024, 1, 1, 17), date(2024, 1, 2, 9)},
+		},
+		{
+			name:  "Between_WithHourlyByHour",
+			rule:  "FREQ=HOURLY;INTERVAL=4;BYHOUR=8,12,16",
+			start: date(2024, 1, 1, 0),
+			from:  date(2024, 1, 1, 0),
+			to:    date(2024, 1, 1, 23),
+			want:  []time.Time{date(2024, 1, 1, 8), date(2024, 1, 1, 12), date(2024, 1, 1, 16)},
+		},
+		{
+			name:  "Between_WithMinutely",
+			rule:  "FREQ=MINUTELY;INTERVAL=30;COUNT=3",
+			start: date(2024, 1, 1, 9),
+			from:  date(2024, 1, 1, 0),
+			to:    date(2024, 1, 1, 23),
+			want: []time.Time{
+				date(2024, 1, 1, 9), date(2024, 1, 1, 9).Add(30 * time.Minute), date(2024, 1, 1, 10),
+			},
+		},
+		{
+			name:  "Between_WithImpossibleRule",
+			rule:  "FREQ=MONTHLY;BYMONTH=2;BYMONTHDAY=30",
+			start: date(2024, 1, 1, 9),
+			from:  date(2024, 1, 1, 0),
+			to:    date(2034, 1, 1, 0),
+			want:  nil,
+		},
+		{
+			name:  "Between_WithSparseMinutelyCount",
+			rule:  "FREQ=MINUTELY;BYMONTH=1;BYHOUR=9;BYMINUTE=0;COUNT=33",
+			start: date(2023, 1, 1, 9),
+			from:  date(2024, 1, 1, 0),
+			to:    date(2024, 2, 1, 0),
+			want:  []time.Time{date(2024, 1, 1, 9), date(2024, 1, 2, 9)},
+		},
+		{
+			name:  "Between_WithSparseMinutely",
+			rule:  "FREQ=MINUTELY;BYMONTH=1;BYHOUR=9;BYMINUTE=0",
+			start: date(2023, 1, 1, 9),
+			from:  date(2024, 1, 30, 0),
+			to:    date(2024, 2, 1, 0),
+			want:  []time.Time{date(2024, 1, 30, 9), date(2024, 1, 31, 9)},
+		},
+		{
+			name:  "Between_WithDailyFarFromStart",
+			rule:  "FREQ=DAILY;INTERVAL=3",
+			start: date(2000, 1, 1, 9),
+			from:  date(2024, 1, 1, 0),
+			to:    date(2024, 1, 7, 0),
+			want:  []time.Time{date(2024, 1, 1, 9), date(2024, 1, 4, 9)},
+		},
+		{
+			name:  "Between_WithWeeklyFarFromStart",
+			rule:  "FREQ=WEEKLY;INTERVAL=2;BYDAY=FR",
+			start: date(2000, 1, 7, 9),
+			from:  date(2024, 1, 1, 0),
+			to:    date(2024, 1, 31, 0),
+			want:  []time.Time{date(2024, 1, 5, 9), date(2024, 1, 19, 9)},
+		},
+		{
+			name:  "Between_WithHourlyFarFromStart",
+			rule:  "FREQ=HOURLY;INTERVAL=5",
+			start: date(2000, 1, 1, 0),
+			from:  date(2024, 1, 1, 0),
+			to:    date(2024, 1, 1, 7),
+			want:  []time.Time{date(2024, 1, 1, 1), date(2024, 1, 1, 6)},
+		},
+		{
+			name:  "Between_WithDaylightSavingTime",
+			rule:  "FREQ=DAILY;COUNT=3",
+			start: time.Date(2024, 3, 9, 9, 0, 0, 0, newYork),
+			from:  date(2024, 3, 1, 0),
+			to:    date(2024, 3, 31, 0),
+			want: []time.Time{
+				time.Date(2024, 3, 9, 9, 0, 0, 0, newYork),
+				time.Date(2024, 3, 10, 9, 0, 0, 0, newYork),
+				time.Date(2024, 3, 11, 9, 0, 0, 0, newYork),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				rule, err := ParseRRule(tt.rule)
+				assert.NoError(t, err)
+
+				got := rule.WithStart(tt.start).Between(tt.from, tt.to)
+				assert.Equal(t, len(tt.want), len(got))
+				for i := range tt.want {
+					assert.True(t, tt.want[i].Equal(got[i]), "%s != %s", tt.want[i], got[i])
+				}
+			},
+		)
+	}
+}
+
+func TestRRuleBetweenWithRDateAndExDate(t *testing.T) {
+	rule, err := ParseRRule(
+		"DTSTART;TZID=America/New_York:20240101T090000\n" +
+			"RRULE:FREQ=DAILY;COUNT=3\n" +
+			"EXDATE;TZID=America/New_York:20240102T090000\n" +
+			"RDATE:20240110T150000Z",
+	)
+	assert.NoError(t, err)
+
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+
+	got := rule.Between(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC))
+	want := []time.Time{
+		time.Date(2024, 1, 1, 9, 0, 0, 0, newYork),
+		time.Date(2024, 1, 3, 9, 0, 0, 0, newYork),
+		time.Date(2024, 1, 10, 15, 0, 0, 0, time.UTC),
+	}
+
+	assert.Equal(t, len(want), len(got))
+	for i := range want {
+		assert.True(t, want[i].Equal(got[i]), "%s != %s", want[i], got[i])
+	}
+}
+
+func TestRRuleExpandFarFromStart(t *testing.T) {
+	rule, err := ParseRRule("FREQ=MINUTELY")
+	assert.NoError(t, err)
+
+	rule = rule.WithStart(time.Date(2000, 1, 1, 0, 0, 30, 0, time.UTC)).WithDuration(time.Second)
+	got := rule.Expand(NewDefaultPeriod(time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC), time.Date(2024, 6, 1, 13, 0, 0, 0, time.UTC)))
+
+	assert.Equal(t, 60, got.Count())
+	assert.Equal(t, time.Date(2024, 6, 1, 12, 0, 30, 0, time.UTC), got.Get(0).GetStartDate())
+}
+
+func TestRRuleBetweenWithoutStart(t *testing.T) {
+	rule, err := ParseRRule("FREQ=DAILY;COUNT=3")
+	assert.NoError(t, err)
+
+	assert.Empty(t, rule.Between(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestRRuleExpand(t *testing.T) {
+	rule, err := ParseRRule("FREQ=WEEKLY;BYDAY=MO,WE")
+	assert.NoError(t, err)
+
+	rule = rule.WithStart(time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)).WithDuration(time.Hour)
+
+	tests := []struct {
+		name   string
+		rule   RRule
+		window Period
+		want   Sequence
+	}{
+		{
+			name: "Expand_WithWindow",
+			rule: rule,
+			window: NewDefaultPeriod(
+				time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 1, 9, 0, 0, 0, 0, time.UTC),
+			),
+			want: NewSequence(
+				NewDefaultPeriod(time.Date(2024, 1, 3, 9, 0, 0, 0, time.UTC), time.Date(2024, 1, 3, 10, 0, 0, 0, time.UTC)),
+				NewDefaultPeriod(time.Date(2024, 1, 8, 9, 0, 0, 0, time.UTC), time.Date(2024, 1, 8, 10, 0, 0, 0, time.UTC)),
+			),
+		},
+		{
+			name: "Expand_WithOccurrenceStartingBeforeWindow",
+			rule: rule,
+			window: NewDefaultPeriod(
+				time.Date(2024, 1, 3, 9, 30, 0, 0, time.UTC),
+				time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC),
+			),
+			want: NewSequence(
+				NewDefaultPeriod(time.Date(2024, 1, 3, 9, 0, 0, 0, time.UTC), time.Date(2024, 1, 3, 10, 0, 0, 0, time.UTC)),
+			),
+		},
+		{
+			name: "Expand_WithBoundaryType",
+			rule: rule.WithBoundaryType(IncludeAll),
+			window: NewDefaultPeriod(
+				time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC),
+			),
+			want: NewSequence(
+				NewIncludeAllPeriod(time.Date(2024, 1, 3, 9, 0, 0, 0, time.UTC), time.Date(2024, 1, 3, 10, 0, 0, 0, time.UTC)),
+			),
+		},
+		{
+			name: "Expand_WithZeroDuration",
+			rule: rule.WithDuration(0),
+			window: NewDefaultPeriod(
+				time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC),
+			),
+			want: NewSequence(
+				NewDefaultPeriod(time.Date(2024, 1, 3, 9, 0, 0, 0, time.UTC), time.Date(2024, 1, 3, 9, 0, 0, 0, time.UTC)),
+			),
+		},
+		{
+			name: "Expand_WithExDate",
+			rule: rule.WithExDates(time.Date(2024, 1, 3, 9, 0, 0, 0, time.UTC)),
+			window: NewDefaultPeriod(
+				time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC),
+			),
+			want: NewSequence(),
+		},
+		{
+			name: "Expand_WithoutStart",
+			rule: rule.WithStart(time.Time{}).WithRDates(time.Date(2024, 1, 3, 15, 0, 0, 0, time.UTC)),
+			window: NewDefaultPeriod(
+				time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC),
+			),
+			want: NewSequence(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got := tt.rule.Expand(tt.window)
+				assert.True(t, tt.want.Equals(got))
+			},
+		)
+	}
+}
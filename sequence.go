@@ -1,7 +1,9 @@
 package period
 
 import (
+	"slices"
 	"sort"
+	"time"
 )
 
 type Sequence struct {
@@ -322,13 +324,7 @@ func (s Sequence) GetTotalTimestampInterval() int64 {
 }
 
 func (s Sequence) sortByStartDate(period, other Period) int64 {
-	if period.startDate.Equal(other.startDate) {
-		return 0
-	} else if period.startDate.Before(other.startDate) {
-		return -1
-	}
-
-	return 1
+	return int64(Compare(period, other))
 }
 
 func (s Sequence) Contains(periods ...Period) bool {
@@ -405,3 +401,226 @@ func (s Sequence) GetInterval() []Period {
 
 	return s.intervals
 }
+
+// Coalesce merges the periods separated by a gap of at most tolerance, abutting periods are merged when tolerance is positive or abutting is true
+func (s Sequence) Coalesce(tolerance time.Duration, abutting bool) Sequence {
+	periods := slices.Clone(s.intervals)
+	slices.SortStableFunc(periods, Compare)
+
+	return Sequence{
+		intervals: unionSorted(
+			periods, func(current, next Period) bool {
+				_, overlaps := intersectPeriods(current, next)
+
+				return overlaps || current.closeTo(next, tolerance, abutting)
+			},
+		),
+	}
+}
+
+// Complement returns the parts of the window not covered by the sequence, sorted by start date
+func (s Sequence) Complement(window Period) Sequence {
+	return NewSequence(subtractSorted([]Period{window}, s.normalize().intervals)...)
+}
+
+// Intersect returns the periods covered by both sequences, sorted by start date
+func (s Sequence) Intersect(other Sequence) Sequence {
+	var sequence Sequence
+
+	left, right := s.normalize().intervals, other.normalize().intervals
+
+	for i, j := 0, 0; i < len(left) && j < len(right); {
+		if period, ok := intersectPeriods(left[i], right[j]); ok {
+			sequence = sequence.Push(period)
+		}
+
+		switch c := compareEnd(left[i], right[j]); {
+		case c < 0:
+			i++
+		case c > 0:
+			j++
+		default:
+			i++
+			j++
+		}
+	}
+
+	return sequence
+}
+
+// Union returns the periods covered by either sequence, sorted by start date
+func (s Sequence) Union(other Sequence) Sequence {
+	return NewSequence(unionSorted(mergeByStartDate(s.normalize().intervals, other.normalize().intervals), joins)...)
+}
+
+// SymmetricDifference returns the periods covered by exactly one of the sequences, sorted by start date
+func (s Sequence) SymmetricDifference(other Sequence) Sequence {
+	left, right := s.normalize().intervals, other.normalize().intervals
+
+	return NewSequence(mergeByStartDate(subtractSorted(left, right), subtractSorted(right, left))...)
+}
+
+func (s Sequence) IsSubsetOf(other Sequence) bool {
+	return len(subtractSorted(s.normalize().intervals, other.normalize().intervals)) == 0
+}
+
+// normalize returns the sorted union of a copy of the sequence, empty periods are dropped
+func (s Sequence) normalize() Sequence {
+	periods := slices.Clone(s.intervals)
+	slices.SortStableFunc(periods, Compare)
+
+	return Sequence{intervals: unionSorted(periods, joins)}
+}
+
+// unionSorted merges each period into the previous one when merge reports so, they must be sorted with Compare
+func unionSorted(periods []Period, merge func(current, next Period) bool) []Period {
+	var union []Period
+
+	for _, period := range periods {
+		if period.isEmpty() {
+			continue
+		}
+
+		last := len(union) - 1
+		if last < 0 || !merge(union[last], period) {
+			union = append(union, period)
+			continue
+		}
+
+		if compareEnd(period, union[last]) > 0 {
+			union[last] = boundedPeriod(union[last], period)
+		}
+	}
+
+	return union
+}
+
+// joins reports whether next starts before current ends or right at its end with no point left uncovered
+func joins(current, next Period) bool {
+	if c := next.startDate.Compare(current.endDate); c != 0 {
+		return c < 0
+	}
+
+	return boundaryIsEndIncluded(current.GetBoundaryType()) || boundaryIsStartIncluded(next.GetBoundaryType())
+}
+
+// intersectPeriods returns the part covered by both periods, on shared endpoints the excluded bound wins
+func intersectPeriods(a, b Period) (Period, bool) {
+	start, end := a, a
+	if compareStart(b, a) > 0 {
+		start = b
+	}
+
+	if compareEnd(b, a) < 0 {
+		end = b
+	}
+
+	period := boundedPeriod(start, end)
+
+	return period, !period.isEmpty()
+}
+
+// boundedPeriod returns the period starting like start and ending like end
+func boundedPeriod(start, end Period) Period {
+	boundaryType := start.GetBoundaryType()[:1] + end.GetBoundaryType()[1:]
+
+	return Period{startDate: start.startDate, endDate: end.endDate, boundaryType: boundaryType}
+}
+
+func mergeByStartDate(left, right []Period) []Period {
+	merged := make([]Period, 0, len(left)+len(right))
+
+	for len(left) > 0 && len(right) > 0 {
+		if Compare(right[0], left[0]) < 0 {
+			merged = append(merged, right[0])
+			right = right[1:]
+			continue
+		}
+
+		merged = append(merged, left[0])
+		left = left[1:]
+	}
+
+	merged = append(merged, left...)
+
+	return append(merged, right...)
+}
+
+// subtractSorted removes the right periods from the left ones, both must be normalized
+func subtractSorted(left, right []Period) []Period {
+	var periods []Period
+
+	j := 0
+	for _, period := range left {
+		for j < len(right) && right[j].endDate.Before(period.startDate) {
+			j++
+		}
+
+		remaining, ok := period, true
+
+		for k := j; ok && k < len(right) && !right[k].startDate.After(remaining.endDate); k++ {
+			if _, overlaps := intersectPeriods(remaining, right[k]); !overlaps {
+				continue
+			}
+
+			if before := remaining.before(right[k]); !before.isEmpty() {
+				periods = append(periods, before)
+			}
+
+			remaining, ok = remaining.after(right[k])
+		}
+
+		if ok && !remaining.isEmpty() {
+			periods = append(periods, remaining)
+		}
+	}
+
+	return periods
+}
+
+// Boundaries returns the smallest period containing every period of the sequence, ok is false when it is empty
+func (s Sequence) Boundaries() (Period, bool) {
+	if s.IsEmpty() {
+		return Period{}, false
+	}
+
+	start, end := s.intervals[0], s.intervals[0]
+	for _, period := range s.intervals[1:] {
+		if compareStart(period, start) < 0 {
+			start = period
+		}
+
+		if compareEnd(period, end) > 0 {
+			end = period
+		}
+	}
+
+	return boundedPeriod(start, end), true
+}
+
+func (s Sequence) Earliest() time.Time {
+	boundaries, _ := s.Boundaries()
+
+	return boundaries.startDate
+}
+
+func (s Sequence) Latest() time.Time {
+	boundaries, _ := s.Boundaries()
+
+	return boundaries.endDate
+}
+
+// CoveredDuration returns the length of the union, overlapping periods are only counted once
+func (s Sequence) CoveredDuration() time.Duration {
+	return s.TotalDuration(DurationUnion)
+}
+
+// CoverageRatio returns the share of the window covered by the sequence, between 0 and 1
+func (s Sequence) CoverageRatio(window Period) float64 {
+	interval := window.GetDateInterval()
+	if interval <= 0 {
+		return 0
+	}
+
+	return float64(s.Intersect(NewSequence(window)).CoveredDuration()) / float64(interval)
+}
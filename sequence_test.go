@@ -2901,3 +2901,495 @@ func TestSequenceIntersections(t *testing.T) {
 		)
 	}
 }
+
+func TestSequenceCoalesce(t *testing.T) {
+	tests := []struct {
+		name      string
+		sequence  Sequence
+		tolerance time.Duration
+		abutting  bool
+		want      Sequence
+	}{
+		{
+			name: "Coalesce_WithGapWithinTolerance",
+			sequence: NewSequence(
+				NewDefaultPeriod(hour(4), hour(6)),
+				NewDefaultPeriod(hour(0), hour(1)),
+				NewDefaultPeriod(hour(1).Add(5*time.Minute), hour(2)),
+			),
+			tolerance: 10 * time.Minute,
+			want: NewSequence(
+				NewDefaultPeriod(hour(0), hour(2)),
+				NewDefaultPeriod(hour(4), hour(6)),
+			),
+		},
+		{
+			name: "Coalesce_WithAbuttingPeriodsKept",
+			sequence: NewSequence(
+				NewDefaultPeriod(hour(0), hour(1)),
+				NewDefaultPeriod(hour(1), hour(2)),
+			),
+			want: NewSequence(
+				NewDefaultPeriod(hour(0), hour(1)),
+				NewDefaultPeriod(hour(1), hour(2)),
+			),
+		},
+		{
+			name: "Coalesce_WithAbuttingPeriodsMerged",
+			sequence: NewSequence(
+				NewDefaultPeriod(hour(0), hour(1)),
+				NewPeriod(hour(1), hour(2), ExcludeStartIncludeEnd),
+			),
+			abutting: true,
+			want: NewSequence(
+				NewIncludeAllPeriod(hour(0), hour(2)),
+			),
+		},
+		{
+			name: "Coalesce_WithOverlapsAndContained",
+			sequence: NewSequence(
+				NewDefaultPeriod(hour(0), hour(5)),
+				NewDefaultPeriod(hour(1), hour(2)),
+				NewDefaultPeriod(hour(4), hour(7)),
+			),
+			want: NewSequence(
+				NewDefaultPeriod(hour(0), hour(7)),
+			),
+		},
+		{
+			name: "Coalesce_WithSharedEndMixedBounds",
+			sequence: NewSequence(
+				NewIncludeAllPeriod(hour(1), hour(3)),
+				NewDefaultPeriod(hour(1), hour(3)),
+			),
+			want: NewSequence(
+				NewIncludeAllPeriod(hour(1), hour(3)),
+			),
+		},
+		{
+			name: "Coalesce_WithSharedEndMixedBoundsReversed",
+			sequence: NewSequence(
+				NewDefaultPeriod(hour(1), hour(3)),
+				NewIncludeAllPeriod(hour(1), hour(3)),
+			),
+			want: NewSequence(
+				NewIncludeAllPeriod(hour(1), hour(3)),
+			),
+		},
+		{
+			name: "Coalesce_WithSharedStartMixedBounds",
+			sequence: NewSequence(
+				NewPeriod(hour(1), hour(3), ExcludeAll),
+				NewDefaultPeriod(hour(1), hour(2)),
+			),
+			want: NewSequence(
+				NewDefaultPeriod(hour(1), hour(3)),
+			),
+		},
+		{
+			name: "Coalesce_WithSharedIncludedPoint",
+			sequence: NewSequence(
+				NewIncludeAllPeriod(hour(1), hour(2)),
+				NewDefaultPeriod(hour(2), hour(3)),
+			),
+			want: NewSequence(
+				NewDefaultPeriod(hour(1), hour(3)),
+			),
+		},
+		{
+			name: "Coalesce_WithEmptyPeriods",
+			sequence: NewSequence(
+				NewDefaultPeriod(hour(1), hour(1)),
+				NewDefaultPeriod(hour(2), hour(3)),
+				NewPeriod(hour(5), hour(5), ExcludeAll),
+			),
+			tolerance: time.Hour,
+			want: NewSequence(
+				NewDefaultPeriod(hour(2), hour(3)),
+			),
+		},
+		{
+			name:      "Coalesce_WithEmptySequence",
+			sequence:  NewSequence(),
+			tolerance: time.Hour,
+			want:      Sequence{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.want, tt.sequence.Coalesce(tt.tolerance, tt.abutting))
+			},
+		)
+	}
+}
+
+func TestSequenceComplement(t *testing.T) {
+	tests := []struct {
+		name     string
+		sequence Sequence
+		window   Period
+		want     Sequence
+	}{
+		{
+			name: "Complement_WithLeadingAndTrailingGaps",
+			sequence: NewSequence(
+				NewDefaultPeriod(hour(5), hour(6)),
+				NewDefaultPeriod(hour(2), hour(3)),
+			),
+			window: NewDefaultPeriod(hour(0), hour(8)),
+			want: NewSequence(
+				NewDefaultPeriod(hour(0), hour(2)),
+				NewDefaultPeriod(hour(3), hour(5)),
+				NewDefaultPeriod(hour(6), hour(8)),
+			),
+		},
+		{
+			name: "Complement_WithBoundaryFlips",
+			sequence: NewSequence(
+				NewIncludeAllPeriod(hour(2), hour(3)),
+			),
+			window: NewIncludeAllPeriod(hour(0), hour(8)),
+			want: NewSequence(
+				NewDefaultPeriod(hour(0), hour(2)),
+				NewPeriod(hour(3), hour(8), ExcludeStartIncludeEnd),
+			),
+		},
+		{
+			name: "Complement_WithSharedEndMixedBounds",
+			sequence: NewSequence(
+				NewDefaultPeriod(hour(1), hour(3)),
+				NewIncludeAllPeriod(hour(2), hour(3)),
+			),
+			window: NewDefaultPeriod(hour(0), hour(4)),
+			want: NewSequence(
+				NewDefaultPeriod(hour(0), hour(1)),
+				NewPeriod(hour(3), hour(4), ExcludeAll),
+			),
+		},
+		{
+			name: "Complement_WithSequenceOutsideWindow",
+			sequence: NewSequence(
+				NewDefaultPeriod(hour(10), hour(12)),
+			),
+			window: NewDefaultPeriod(hour(0), hour(8)),
+			want: NewSequence(
+				NewDefaultPeriod(hour(0), hour(8)),
+			),
+		},
+		{
+			name: "Complement_WithIncludedEndLeft",
+			sequence: NewSequence(
+				NewDefaultPeriod(hour(0), hour(8)),
+			),
+			window: NewIncludeAllPeriod(hour(0), hour(8)),
+			want: NewSequence(
+				NewIncludeAllPeriod(hour(8), hour(8)),
+			),
+		},
+		{
+			name: "Complement_WithWindowCovered",
+			sequence: NewSequence(
+				NewDefaultPeriod(hour(0), hour(4)),
+				NewDefaultPeriod(hour(3), hour(9)),
+			),
+			window: NewDefaultPeriod(hour(1), hour(8)),
+			want:   NewSequence(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got := tt.sequence.Complement(tt.window)
+				assert.Equal(t, tt.want.Count(), got.Count())
+				for i := 0; i < tt.want.Count(); i++ {
+					assert.True(t, tt.want.Get(i).Equals(got.Get(i)), "%v != %v", tt.want.Get(i), got.Get(i))
+				}
+			},
+		)
+	}
+}
+
+func TestSequenceSetOperations(t *testing.T) {
+	onCall := NewSequence(
+		NewDefaultPeriod(hour(8), hour(12)),
+		NewDefaultPeriod(hour(0), hour(2)),
+		NewIncludeAllPeriod(hour(14), hour(18)),
+	)
+	approver := NewSequence(
+		NewDefaultPeriod(hour(1), hour(3)),
+		NewDefaultPeriod(hour(10), hour(16)),
+		NewDefaultPeriod(hour(17), hour(20)),
+	)
+
+	tests := []struct {
+		name string
+		got  Sequence
+		want Sequence
+	}{
+		{
+			name: "Intersect_WithOnCallAndApprover",
+			got:  onCall.Intersect(approver),
+			want: NewSequence(
+				NewDefaultPeriod(hour(1), hour(2)),
+				NewDefaultPeriod(hour(10), hour(12)),
+				NewDefaultPeriod(hour(14), hour(16)),
+				NewIncludeAllPeriod(hour(17), hour(18)),
+			),
+		},
+		{
+			name: "Union_WithOnCallAndApprover",
+			got:  onCall.Union(approver),
+			want: NewSequence(
+				NewDefaultPeriod(hour(0), hour(3)),
+				NewDefaultPeriod(hour(8), hour(20)),
+			),
+		},
+		{
+			name: "SymmetricDifference_WithOnCallAndApprover",
+			got:  onCall.SymmetricDifference(approver),
+			want: NewSequence(
+				NewDefaultPeriod(hour(0), hour(1)),
+				NewDefaultPeriod(hour(2), hour(3)),
+				NewDefaultPeriod(hour(8), hour(10)),
+				NewDefaultPeriod(hour(12), hour(14)),
+				NewDefaultPeriod(hour(16), hour(17)),
+				NewPeriod(hour(18), hour(20), ExcludeAll),
+			),
+		},
+		{
+			name: "Intersect_WithEmptySequence",
+			got:  onCall.Intersect(NewSequence()),
+			want: NewSequence(),
+		},
+		{
+			name: "Union_WithEmptySequence",
+			got:  NewSequence().Union(NewSequence(NewDefaultPeriod(hour(0), hour(1)))),
+			want: NewSequence(NewDefaultPeriod(hour(0), hour(1))),
+		},
+		{
+			name: "Intersect_WithSharedExcludedStart",
+			got:  NewSequence(NewDefaultPeriod(hour(1), hour(3))).Intersect(NewSequence(NewPeriod(hour(1), hour(2), ExcludeAll))),
+			want: NewSequence(NewPeriod(hour(1), hour(2), ExcludeAll)),
+		},
+		{
+			name: "Intersect_WithSharedExcludedStartReversed",
+			got:  NewSequence(NewPeriod(hour(1), hour(2), ExcludeAll)).Intersect(NewSequence(NewDefaultPeriod(hour(1), hour(3)))),
+			want: NewSequence(NewPeriod(hour(1), hour(2), ExcludeAll)),
+		},
+		{
+			name: "Intersect_WithSharedExcludedEnd",
+			got:  NewSequence(NewIncludeAllPeriod(hour(1), hour(3))).Intersect(NewSequence(NewDefaultPeriod(hour(2), hour(3)))),
+			want: NewSequence(NewDefaultPeriod(hour(2), hour(3))),
+		},
+		{
+			name: "Intersect_WithSharedExcludedEndReversed",
+			got:  NewSequence(NewDefaultPeriod(hour(2), hour(3))).Intersect(NewSequence(NewIncludeAllPeriod(hour(1), hour(3)))),
+			want: NewSequence(NewDefaultPeriod(hour(2), hour(3))),
+		},
+		{
+			name: "Intersect_WithTouchingIncludedPoint",
+			got:  NewSequence(NewIncludeAllPeriod(hour(1), hour(2))).Intersect(NewSequence(NewDefaultPeriod(hour(2), hour(3)))),
+			want: NewSequence(NewIncludeAllPeriod(hour(2), hour(2))),
+		},
+		{
+			name: "Union_WithSharedStartMixedBounds",
+			got:  NewSequence(NewPeriod(hour(1), hour(3), ExcludeAll)).Union(NewSequence(NewDefaultPeriod(hour(1), hour(2)))),
+			want: NewSequence(NewDefaultPeriod(hour(1), hour(3))),
+		},
+		{
+			name: "Union_WithSharedStartMixedBoundsReversed",
+			got:  NewSequence(NewDefaultPeriod(hour(1), hour(2))).Union(NewSequence(NewPeriod(hour(1), hour(3), ExcludeAll))),
+			want: NewSequence(NewDefaultPeriod(hour(1), hour(3))),
+		},
+		{
+			name: "Union_WithSharedEndMixedBounds",
+			got:  NewSequence(NewDefaultPeriod(hour(1), hour(3))).Union(NewSequence(NewIncludeAllPeriod(hour(2), hour(3)))),
+			want: NewSequence(NewIncludeAllPeriod(hour(1), hour(3))),
+		},
+		{
+			name: "Union_WithSharedEndMixedBoundsReversed",
+			got:  NewSequence(NewIncludeAllPeriod(hour(2), hour(3))).Union(NewSequence(NewDefaultPeriod(hour(1), hour(3)))),
+			want: NewSequence(NewIncludeAllPeriod(hour(1), hour(3))),
+		},
+		{
+			name: "Union_WithExcludedPointBetween",
+			got:  NewSequence(NewDefaultPeriod(hour(1), hour(2))).Union(NewSequence(NewPeriod(hour(2), hour(3), ExcludeAll))),
+			want: NewSequence(NewDefaultPeriod(hour(1), hour(2)), NewPeriod(hour(2), hour(3), ExcludeAll)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.want.Count(), tt.got.Count())
+				for i := 0; i < tt.want.Count() && i < tt.got.Count(); i++ {
+					assert.True(t, tt.want.Get(i).Equals(tt.got.Get(i)), "%v != %v", tt.want.Get(i), tt.got.Get(i))
+				}
+			},
+		)
+	}
+
+	assert.Equal(t, hour(8), onCall.Get(0).GetStartDate())
+}
+
+func TestSequenceIsSubsetOf(t *testing.T) {
+	tests := []struct {
+		name     string
+		sequence Sequence
+		other    Sequence
+		want     bool
+	}{
+		{
+			name:     "IsSubsetOf_WithCoveredPeriods",
+			sequence: NewSequence(NewDefaultPeriod(hour(1), hour(2)), NewDefaultPeriod(hour(5), hour(6))),
+			other:    NewSequence(NewDefaultPeriod(hour(0), hour(3)), NewDefaultPeriod(hour(4), hour(8))),
+			want:     true,
+		},
+		{
+			name:     "IsSubsetOf_WithIncludedEndNotCovered",
+			sequence: NewSequence(NewIncludeAllPeriod(hour(1), hour(3))),
+			other:    NewSequence(NewDefaultPeriod(hour(0), hour(3))),
+			want:     false,
+		},
+		{
+			name:     "IsSubsetOf_WithSharedEndMixedBounds",
+			sequence: NewSequence(NewIncludeAllPeriod(hour(1), hour(3))),
+			other:    NewSequence(NewDefaultPeriod(hour(1), hour(3)), NewIncludeAllPeriod(hour(2), hour(3))),
+			want:     true,
+		},
+		{
+			name:     "IsSubsetOf_WithSharedEndMixedBoundsReversed",
+			sequence: NewSequence(NewIncludeAllPeriod(hour(1), hour(3))),
+			other:    NewSequence(NewIncludeAllPeriod(hour(2), hour(3)), NewDefaultPeriod(hour(1), hour(3))),
+			want:     true,
+		},
+		{
+			name:     "IsSubsetOf_WithSharedStartExcluded",
+			sequence: NewSequence(NewDefaultPeriod(hour(1), hour(2))),
+			other:    NewSequence(NewPeriod(hour(1), hour(3), ExcludeAll)),
+			want:     false,
+		},
+		{
+			name:     "IsSubsetOf_WithPartialCover",
+			sequence: NewSequence(NewDefaultPeriod(hour(1), hour(5))),
+			other:    NewSequence(NewDefaultPeriod(hour(0), hour(3)), NewDefaultPeriod(hour(4), hour(8))),
+			want:     false,
+		},
+		{
+			name:     "IsSubsetOf_WithEmptySequence",
+			sequence: NewSequence(),
+			other:    NewSequence(),
+			want:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.want, tt.sequence.IsSubsetOf(tt.other))
+			},
+		)
+	}
+}
+
+func TestSequenceBoundaries(t *testing.T) {
+	tests := []struct {
+		name         string
+		sequence     Sequence
+		want         Period
+		wantOk       bool
+		wantEarliest time.Time
+		wantLatest   time.Time
+	}{
+		{
+			name: "Boundaries_WithUnsortedPeriods",
+			sequence: NewSequence(
+				NewDefaultPeriod(hour(4), hour(6)),
+				NewPeriod(hour(1), hour(2), ExcludeAll),
+				NewIncludeAllPeriod(hour(5), hour(9)),
+			),
+			want:         NewPeriod(hour(1), hour(9), ExcludeStartIncludeEnd),
+			wantOk:       true,
+			wantEarliest: hour(1),
+			wantLatest:   hour(9),
+		},
+		{
+			name: "Boundaries_WithSharedEndpointsMixedBounds",
+			sequence: NewSequence(
+				NewPeriod(hour(1), hour(2), ExcludeAll),
+				NewIncludeAllPeriod(hour(0), hour(3)),
+				NewPeriod(hour(0), hour(3), ExcludeAll),
+			),
+			want:         NewIncludeAllPeriod(hour(0), hour(3)),
+			wantOk:       true,
+			wantEarliest: hour(0),
+			wantLatest:   hour(3),
+		},
+		{
+			name:     "Boundaries_WithEmptySequence",
+			sequence: NewSequence(),
+			want:     Period{},
+			wantOk:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, ok := tt.sequence.Boundaries()
+				assert.Equal(t, tt.wantOk, ok)
+				assert.True(t, tt.want.Equals(got), "%v != %v", tt.want, got)
+				assert.Equal(t, tt.wantEarliest, tt.sequence.Earliest())
+				assert.Equal(t, tt.wantLatest, tt.sequence.Latest())
+			},
+		)
+	}
+}
+
+func TestSequenceCoverage(t *testing.T) {
+	tests := []struct {
+		name        string
+		sequence    Sequence
+		window      Period
+		wantCovered time.Duration
+		wantRatio   float64
+	}{
+		{
+			name: "Coverage_WithOverlappingPeriods",
+			sequence: NewSequence(
+				NewDefaultPeriod(hour(0), hour(3)),
+				NewDefaultPeriod(hour(2), hour(4)),
+				NewDefaultPeriod(hour(6), hour(7)),
+			),
+			window:      NewDefaultPeriod(hour(2), hour(10)),
+			wantCovered: 5 * time.Hour,
+			wantRatio:   3.0 / 8,
+		},
+		{
+			name:        "Coverage_WithEmptyWindow",
+			sequence:    NewSequence(NewDefaultPeriod(hour(0), hour(3))),
+			window:      NewDefaultPeriod(hour(2), hour(2)),
+			wantCovered: 3 * time.Hour,
+			wantRatio:   0,
+		},
+		{
+			name:        "Coverage_WithEmptySequence",
+			sequence:    NewSequence(),
+			window:      NewDefaultPeriod(hour(0), hour(2)),
+			wantCovered: 0,
+			wantRatio:   0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.wantCovered, tt.sequence.CoveredDuration())
+				assert.InDelta(t, tt.wantRatio, tt.sequence.CoverageRatio(tt.window), 1e-9)
+			},
+		)
+	}
+}
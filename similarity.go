@@ -0,0 +1,73 @@
+package period
+
+import (
+	"time"
+)
+
+// Distance returns the gap length between the periods, or the negated overlap length when they overlap,
+// abutting and meeting periods are at distance 0
+func (p Period) Distance(other Period) time.Duration {
+	if p.Overlaps(other) {
+		return -p.Intersect(other).GetDateInterval()
+	}
+
+	if other.startDate.Before(p.startDate) {
+		return p.startDate.Sub(other.endDate)
+	}
+
+	return other.startDate.Sub(p.endDate)
+}
+
+// OverlapRatio returns the share of the period covered by other, 0 when the period has no duration
+func (p Period) OverlapRatio(other Period) float64 {
+	return durationRatio(p.overlap(other), p.GetDateInterval())
+}
+
+// Jaccard returns the intersection over union of the periods, 0 when they have no duration
+func (p Period) Jaccard(other Period) float64 {
+	overlap := p.overlap(other)
+
+	return durationRatio(overlap, p.GetDateInterval()+other.GetDateInterval()-overlap)
+}
+
+func (p Period) overlap(other Period) time.Duration {
+	if !p.Overlaps(other) {
+		return 0
+	}
+
+	return p.Intersect(other).GetDateInterval()
+}
+
+// OverlapRatio returns the share of the sequence covered by other, 0 when the sequence has no duration
+func (s Sequence) OverlapRatio(other Sequence) float64 {
+	return durationRatio(s.Intersect(other).CoveredDuration(), s.CoveredDuration())
+}
+
+// Jaccard returns the intersection over union of the sequences, 0 when they have no duration
+func (s Sequence) Jaccard(other Sequence) float64 {
+	return durationRatio(s.Intersect(other).CoveredDuration(), s.Union(other).CoveredDuration())
+}
+
+// Nearest returns the period with the smallest Distance to the given one, the first one wins ties
+func (s Sequence) Nearest(period Period) (Period, bool) {
+	var (
+		nearest  Period
+		distance time.Duration
+	)
+
+	for i, interval := range s.intervals {
+		if d := interval.Distance(period); i == 0 || d < distance {
+			nearest, distance = interval, d
+		}
+	}
+
+	return nearest, len(s.intervals) > 0
+}
+
+func durationRatio(part, whole time.Duration) float64 {
+	if whole <= 0 {
+		return 0
+	}
+
+	return float64(part) / float64(whole)
+}
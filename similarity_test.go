@@ -0,0 +1,148 @@
+package period
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestPeriodSimilarity(t *testing.T) {
+	tests := []struct {
+		name         string
+		period       Period
+		other        Period
+		wantDistance time.Duration
+		wantRatio    float64
+		wantJaccard  float64
+	}{
+		{
+			name:         "Similarity_WithGapAfter",
+			period:       NewDefaultPeriod(hour(0), hour(2)),
+			other:        NewDefaultPeriod(hour(5), hour(6)),
+			wantDistance: 3 * time.Hour,
+		},
+		{
+			name:         "Similarity_WithGapBefore",
+			period:       NewDefaultPeriod(hour(5), hour(6)),
+			other:        NewDefaultPeriod(hour(0), hour(2)),
+			wantDistance: 3 * time.Hour,
+		},
+		{
+			name:         "Similarity_WithOverlap",
+			period:       NewDefaultPeriod(hour(0), hour(4)),
+			other:        NewDefaultPeriod(hour(2), hour(6)),
+			wantDistance: -2 * time.Hour,
+			wantRatio:    0.5,
+			wantJaccard:  2.0 / 6,
+		},
+		{
+			name:         "Similarity_WithAbuttingPeriods",
+			period:       NewDefaultPeriod(hour(0), hour(2)),
+			other:        NewDefaultPeriod(hour(2), hour(4)),
+			wantDistance: 0,
+		},
+		{
+			name:         "Similarity_WithMeetingPeriods",
+			period:       NewIncludeAllPeriod(hour(0), hour(2)),
+			other:        NewIncludeAllPeriod(hour(2), hour(4)),
+			wantDistance: 0,
+		},
+		{
+			name:         "Similarity_WithContainedPeriod",
+			period:       NewDefaultPeriod(hour(1), hour(2)),
+			other:        NewDefaultPeriod(hour(0), hour(4)),
+			wantDistance: -time.Hour,
+			wantRatio:    1,
+			wantJaccard:  0.25,
+		},
+		{
+			name:         "Similarity_WithEmptyPeriod",
+			period:       NewIncludeAllPeriod(hour(1), hour(1)),
+			other:        NewDefaultPeriod(hour(0), hour(4)),
+			wantDistance: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.wantDistance, tt.period.Distance(tt.other))
+				assert.InDelta(t, tt.wantRatio, tt.period.OverlapRatio(tt.other), 1e-9)
+				assert.InDelta(t, tt.wantJaccard, tt.period.Jaccard(tt.other), 1e-9)
+				assert.InDelta(t, tt.wantJaccard, tt.other.Jaccard(tt.period), 1e-9)
+			},
+		)
+	}
+}
+
+func TestSequenceSimilarity(t *testing.T) {
+	sequence := NewSequence(
+		NewDefaultPeriod(hour(0), hour(2)),
+		NewDefaultPeriod(hour(4), hour(6)),
+	)
+	other := NewSequence(
+		NewDefaultPeriod(hour(1), hour(5)),
+	)
+
+	assert.InDelta(t, 0.5, sequence.OverlapRatio(other), 1e-9)
+	assert.InDelta(t, 0.5, other.OverlapRatio(sequence), 1e-9)
+	assert.InDelta(t, 2.0/6, sequence.Jaccard(other), 1e-9)
+	assert.InDelta(t, 0, NewSequence().Jaccard(NewSequence()), 1e-9)
+	assert.InDelta(t, 0, NewSequence().OverlapRatio(other), 1e-9)
+}
+
+func TestSequenceNearest(t *testing.T) {
+	sequence := NewSequence(
+		NewDefaultPeriod(hour(0), hour(1)),
+		NewDefaultPeriod(hour(4), hour(8)),
+		NewDefaultPeriod(hour(5), hour(6)),
+		NewDefaultPeriod(hour(12), hour(13)),
+	)
+
+	tests := []struct {
+		name     string
+		sequence Sequence
+		period   Period
+		want     Period
+		wantOk   bool
+	}{
+		{
+			name:     "Nearest_WithGaps",
+			sequence: sequence,
+			period:   NewDefaultPeriod(hour(10), hour(11)),
+			want:     NewDefaultPeriod(hour(12), hour(13)),
+			wantOk:   true,
+		},
+		{
+			name:     "Nearest_WithLargestOverlap",
+			sequence: sequence,
+			period:   NewDefaultPeriod(hour(5), hour(7)),
+			want:     NewDefaultPeriod(hour(4), hour(8)),
+			wantOk:   true,
+		},
+		{
+			name:     "Nearest_WithTie",
+			sequence: sequence,
+			period:   NewDefaultPeriod(hour(2), hour(3)),
+			want:     NewDefaultPeriod(hour(0), hour(1)),
+			wantOk:   true,
+		},
+		{
+			name:     "Nearest_WithEmptySequence",
+			sequence: NewSequence(),
+			period:   NewDefaultPeriod(hour(2), hour(3)),
+			want:     Period{},
+			wantOk:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, ok := tt.sequence.Nearest(tt.period)
+				assert.Equal(t, tt.wantOk, ok)
+				assert.True(t, tt.want.Equals(got), "%v != %v", tt.want, got)
+			},
+		)
+	}
+}
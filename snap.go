@@ -0,0 +1,155 @@
+package period
+
+import (
+	"time"
+)
+
+// snap modes 对齐方式
+const (
+	SnapOutward = "outward"
+	SnapInward  = "inward"
+	SnapNearest = "nearest"
+)
+
+// calendar units 日历单位
+const (
+	CalendarDay   = "day"
+	CalendarWeek  = "week"
+	CalendarMonth = "month"
+	CalendarYear  = "year"
+)
+
+// Snap aligns the dates to a grid counted from the midnight of each date's day, inward snapping may return an empty period
+func (p Period) Snap(grid time.Duration, mode string) Period {
+	if grid <= 0 {
+		return p
+	}
+
+	return p.snap(
+		func(t time.Time) time.Time {
+			return floorTime(t, grid)
+		},
+		func(t time.Time) time.Time {
+			return ceilTime(t, grid)
+		},
+		mode,
+	)
+}
+
+// SnapCalendar aligns the dates to calendar units in their own location, weeks start on Monday
+func (p Period) SnapCalendar(unit string, mode string) Period {
+	return p.snap(
+		func(t time.Time) time.Time {
+			return floorCalendar(t, unit)
+		},
+		func(t time.Time) time.Time {
+			return ceilCalendar(t, unit)
+		},
+		mode,
+	)
+}
+
+func (p Period) snap(floor, ceil func(time.Time) time.Time, mode string) Period {
+	var startDate, endDate time.Time
+
+	switch mode {
+	case SnapInward:
+		startDate, endDate = ceil(p.startDate), floor(p.endDate)
+		if !endDate.After(startDate) {
+			return NewPeriod(startDate, startDate, IncludeStartExcludeEnd)
+		}
+	case SnapNearest:
+		startDate, endDate = nearestTime(p.startDate, floor, ceil), nearestTime(p.endDate, floor, ceil)
+	default:
+		startDate, endDate = floor(p.startDate), ceil(p.endDate)
+	}
+
+	return p.StartingOn(startDate).EndingOn(endDate)
+}
+
+// Snap snaps every period, the periods emptied by an inward snap are dropped
+func (s Sequence) Snap(grid time.Duration, mode string) Sequence {
+	return s.snap(
+		func(period Period) Period {
+			return period.Snap(grid, mode)
+		},
+	)
+}
+
+func (s Sequence) SnapCalendar(unit string, mode string) Sequence {
+	return s.snap(
+		func(period Period) Period {
+			return period.SnapCalendar(unit, mode)
+		},
+	)
+}
+
+func (s Sequence) snap(transform func(Period) Period) Sequence {
+	var sequence Sequence
+
+	for _, period := range s.intervals {
+		if snapped := transform(period); !snapped.isEmpty() {
+			sequence = sequence.Push(snapped)
+		}
+	}
+
+	return sequence
+}
+
+func nearestTime(t time.Time, floor, ceil func(time.Time) time.Time) time.Time {
+	lower, upper := floor(t), ceil(t)
+	if t.Sub(lower) < upper.Sub(t) {
+		return lower
+	}
+
+	return upper
+}
+
+// floorTime rounds t down to a multiple of d counted from the midnight of t's day in t's location
+func floorTime(t time.Time, d time.Duration) time.Time {
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+
+	return t.Add(-(t.Sub(midnight) % d))
+}
+
+// ceilTime rounds t up to a multiple of d counted from the midnight of t's day in t's location
+func ceilTime(t time.Time, d time.Duration) time.Time {
+	if floor := floorTime(t, d); !floor.Equal(t) {
+		return floor.Add(d)
+	}
+
+	return t
+}
+
+func floorCalendar(t time.Time, unit string) time.Time {
+	year, month, day := t.Date()
+
+	switch unit {
+	case CalendarWeek:
+		return time.Date(year, month, day-(int(t.Weekday())+6)%7, 0, 0, 0, 0, t.Location())
+	case CalendarMonth:
+		return time.Date(year, month, 1, 0, 0, 0, 0, t.Location())
+	case CalendarYear:
+		return time.Date(year, time.January, 1, 0, 0, 0, 0, t.Location())
+	default:
+		return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+	}
+}
+
+func ceilCalendar(t time.Time, unit string) time.Time {
+	floor := floorCalendar(t, unit)
+	if floor.Equal(t) {
+		return t
+	}
+
+	switch unit {
+	case CalendarWeek:
+		return floor.AddDate(0, 0, 7)
+	case CalendarMonth:
+		return floor.AddDate(0, 1, 0)
+	case CalendarYear:
+		return floor.AddDate(1, 0, 0)
+	default:
+		return floor.AddDate(0, 0, 1)
+	}
+}
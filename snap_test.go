@@ -0,0 +1,205 @@
+package period
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestCeilTime(t *testing.T) {
+	tests := []struct {
+		name     string
+		date     time.Time
+		duration time.Duration
+		want     time.Time
+	}{
+		{
+			name:     "CeilTime_WithAlignedTime",
+			date:     time.Date(2023, 1, 2, 10, 30, 0, 0, time.UTC),
+			duration: 30 * time.Minute,
+			want:     time.Date(2023, 1, 2, 10, 30, 0, 0, time.UTC),
+		},
+		{
+			name:     "CeilTime_WithUnalignedTime",
+			date:     time.Date(2023, 1, 2, 10, 31, 0, 0, time.UTC),
+			duration: 30 * time.Minute,
+			want:     time.Date(2023, 1, 2, 11, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "CeilTime_WithHalfHourOffsetLocation",
+			date:     time.Date(2023, 1, 2, 10, 5, 0, 0, time.FixedZone("IST", 5*3600+1800)),
+			duration: time.Hour,
+			want:     time.Date(2023, 1, 2, 11, 0, 0, 0, time.FixedZone("IST", 5*3600+1800)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got := ceilTime(tt.date, tt.duration)
+				assert.True(t, tt.want.Equal(got), "%s != %s", tt.want, got)
+			},
+		)
+	}
+}
+
+func TestPeriodSnap(t *testing.T) {
+	at := func(hour, minute int) time.Time {
+		return time.Date(2023, 1, 2, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name   string
+		period Period
+		grid   time.Duration
+		mode   string
+		want   Period
+	}{
+		{
+			name:   "Snap_WithOutward",
+			period: NewPeriod(at(9, 7), at(10, 20), ExcludeAll),
+			grid:   15 * time.Minute,
+			mode:   SnapOutward,
+			want:   NewPeriod(at(9, 0), at(10, 30), ExcludeAll),
+		},
+		{
+			name:   "Snap_WithInward",
+			period: NewDefaultPeriod(at(9, 7), at(10, 20)),
+			grid:   15 * time.Minute,
+			mode:   SnapInward,
+			want:   NewDefaultPeriod(at(9, 15), at(10, 15)),
+		},
+		{
+			name:   "Snap_WithInwardTooShort",
+			period: NewDefaultPeriod(at(9, 1), at(9, 14)),
+			grid:   15 * time.Minute,
+			mode:   SnapInward,
+			want:   NewDefaultPeriod(at(9, 15), at(9, 15)),
+		},
+		{
+			name:   "Snap_WithInwardTooShortIncludeAll",
+			period: NewIncludeAllPeriod(at(9, 1), at(9, 14)),
+			grid:   15 * time.Minute,
+			mode:   SnapInward,
+			want:   NewDefaultPeriod(at(9, 15), at(9, 15)),
+		},
+		{
+			name:   "Snap_WithNearest",
+			period: NewDefaultPeriod(at(9, 7), at(10, 8)),
+			grid:   15 * time.Minute,
+			mode:   SnapNearest,
+			want:   NewDefaultPeriod(at(9, 0), at(10, 15)),
+		},
+		{
+			name:   "Snap_WithoutGrid",
+			period: NewDefaultPeriod(at(9, 7), at(10, 8)),
+			grid:   0,
+			mode:   SnapNearest,
+			want:   NewDefaultPeriod(at(9, 7), at(10, 8)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got := tt.period.Snap(tt.grid, tt.mode)
+				assert.True(t, tt.want.Equals(got), "%v != %v", tt.want, got)
+			},
+		)
+	}
+}
+
+func TestPeriodSnapCalendar(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		period Period
+		unit   string
+		mode   string
+		want   Period
+	}{
+		{
+			name: "SnapCalendar_WithDayAcrossDST",
+			period: NewDefaultPeriod(
+				time.Date(2023, 3, 11, 15, 0, 0, 0, newYork),
+				time.Date(2023, 3, 12, 9, 0, 0, 0, newYork),
+			),
+			unit: CalendarDay,
+			mode: SnapOutward,
+			want: NewDefaultPeriod(
+				time.Date(2023, 3, 11, 0, 0, 0, 0, newYork),
+				time.Date(2023, 3, 13, 0, 0, 0, 0, newYork),
+			),
+		},
+		{
+			name: "SnapCalendar_WithWeek",
+			period: NewDefaultPeriod(
+				time.Date(2023, 1, 4, 12, 0, 0, 0, time.UTC),
+				time.Date(2023, 1, 9, 0, 0, 0, 0, time.UTC),
+			),
+			unit: CalendarWeek,
+			mode: SnapOutward,
+			want: NewDefaultPeriod(
+				time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC),
+				time.Date(2023, 1, 9, 0, 0, 0, 0, time.UTC),
+			),
+		},
+		{
+			name: "SnapCalendar_WithMonthInward",
+			period: NewDefaultPeriod(
+				time.Date(2023, 1, 15, 0, 0, 0, 0, time.UTC),
+				time.Date(2023, 4, 10, 0, 0, 0, 0, time.UTC),
+			),
+			unit: CalendarMonth,
+			mode: SnapInward,
+			want: NewDefaultPeriod(
+				time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC),
+			),
+		},
+		{
+			name: "SnapCalendar_WithYearNearest",
+			period: NewDefaultPeriod(
+				time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC),
+			),
+			unit: CalendarYear,
+			mode: SnapNearest,
+			want: NewDefaultPeriod(
+				time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got := tt.period.SnapCalendar(tt.unit, tt.mode)
+				assert.True(t, tt.want.Equals(got), "%v != %v", tt.want, got)
+			},
+		)
+	}
+}
+
+func TestSequenceSnap(t *testing.T) {
+	at := func(hour, minute int) time.Time {
+		return time.Date(2023, 1, 2, hour, minute, 0, 0, time.UTC)
+	}
+
+	sequence := NewSequence(
+		NewDefaultPeriod(at(9, 7), at(10, 20)),
+		NewDefaultPeriod(at(11, 1), at(11, 14)),
+		NewIncludeAllPeriod(at(12, 1), at(12, 14)),
+	)
+
+	got := sequence.Snap(15*time.Minute, SnapInward)
+	assert.Equal(t, 1, got.Count())
+	assert.True(t, NewDefaultPeriod(at(9, 15), at(10, 15)).Equals(got.Get(0)))
+
+	got = sequence.SnapCalendar(CalendarDay, SnapOutward)
+	assert.Equal(t, 3, got.Count())
+	assert.True(t, NewDefaultPeriod(at(0, 0), at(24, 0)).Equals(got.Get(1)))
+}
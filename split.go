@@ -0,0 +1,57 @@
+package period
+
+import (
+	"slices"
+	"time"
+)
+
+// SplitAt cuts the period at the given instants, every cut point goes to the piece starting at it
+func (p Period) SplitAt(times ...time.Time) Sequence {
+	return p.SplitAtBoundedBy(IncludeStartExcludeEnd, times...)
+}
+
+// SplitAtBoundedBy cuts the period at the given instants, the bound decides which pieces include the cut points,
+// instants outside the period are ignored and the outer bounds are kept
+func (p Period) SplitAtBoundedBy(bound string, times ...time.Time) Sequence {
+	if _, ok := boundaryTypes[bound]; !ok {
+		bound = IncludeStartExcludeEnd
+	}
+
+	cuts := make([]time.Time, 0, len(times))
+	for _, t := range times {
+		if t.After(p.startDate) && t.Before(p.endDate) {
+			cuts = append(cuts, t)
+		}
+	}
+
+	slices.SortFunc(cuts, time.Time.Compare)
+	cuts = slices.CompactFunc(cuts, time.Time.Equal)
+
+	boundaryType := p.GetBoundaryType()
+	periods := make([]Period, 0, len(cuts)+1)
+	startDate, startBound := p.startDate, boundaryType[0:1]
+
+	for _, cut := range cuts {
+		periods = append(periods, Period{startDate: startDate, endDate: cut, boundaryType: startBound + bound[1:2]})
+		startDate, startBound = cut, bound[0:1]
+	}
+
+	periods = append(periods, Period{startDate: startDate, endDate: p.endDate, boundaryType: startBound + boundaryType[1:2]})
+
+	return Sequence{intervals: periods}
+}
+
+// CutAt splits every period of the sequence at the given instants
+func (s Sequence) CutAt(times ...time.Time) Sequence {
+	return s.CutAtBoundedBy(IncludeStartExcludeEnd, times...)
+}
+
+func (s Sequence) CutAtBoundedBy(bound string, times ...time.Time) Sequence {
+	var sequence Sequence
+
+	for _, period := range s.intervals {
+		sequence = sequence.Push(period.SplitAtBoundedBy(bound, times...).intervals...)
+	}
+
+	return sequence
+}
@@ -0,0 +1,105 @@
+package period
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestPeriodSplitAt(t *testing.T) {
+	tests := []struct {
+		name   string
+		period Period
+		bound  string
+		times  []time.Time
+		want   []Period
+	}{
+		{
+			name:   "SplitAt_WithDefaultBound",
+			period: NewDefaultPeriod(hour(0), hour(6)),
+			bound:  IncludeStartExcludeEnd,
+			times:  []time.Time{hour(4), hour(2), hour(4)},
+			want: []Period{
+				NewDefaultPeriod(hour(0), hour(2)),
+				NewDefaultPeriod(hour(2), hour(4)),
+				NewDefaultPeriod(hour(4), hour(6)),
+			},
+		},
+		{
+			name:   "SplitAt_WithExcludeStartIncludeEnd",
+			period: NewIncludeAllPeriod(hour(0), hour(6)),
+			bound:  ExcludeStartIncludeEnd,
+			times:  []time.Time{hour(3)},
+			want: []Period{
+				NewIncludeAllPeriod(hour(0), hour(3)),
+				NewPeriod(hour(3), hour(6), ExcludeStartIncludeEnd),
+			},
+		},
+		{
+			name:   "SplitAt_WithIncludeAll",
+			period: NewPeriod(hour(0), hour(6), ExcludeAll),
+			bound:  IncludeAll,
+			times:  []time.Time{hour(3)},
+			want: []Period{
+				NewPeriod(hour(0), hour(3), ExcludeStartIncludeEnd),
+				NewDefaultPeriod(hour(3), hour(6)),
+			},
+		},
+		{
+			name:   "SplitAt_WithTimesOutsidePeriod",
+			period: NewDefaultPeriod(hour(1), hour(6)),
+			bound:  IncludeStartExcludeEnd,
+			times:  []time.Time{hour(0), hour(1), hour(6), hour(8)},
+			want: []Period{
+				NewDefaultPeriod(hour(1), hour(6)),
+			},
+		},
+		{
+			name:   "SplitAt_WithInvalidBound",
+			period: NewDefaultPeriod(hour(0), hour(6)),
+			bound:  "<>",
+			times:  []time.Time{hour(3)},
+			want: []Period{
+				NewDefaultPeriod(hour(0), hour(3)),
+				NewDefaultPeriod(hour(3), hour(6)),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got := tt.period.SplitAtBoundedBy(tt.bound, tt.times...)
+				assert.Equal(t, len(tt.want), got.Count())
+				for i := range tt.want {
+					assert.True(t, tt.want[i].Equals(got.Get(i)), "%v != %v", tt.want[i], got.Get(i))
+				}
+			},
+		)
+	}
+}
+
+func TestSequenceCutAt(t *testing.T) {
+	sequence := NewSequence(
+		NewDefaultPeriod(hour(22).AddDate(0, 0, -1), hour(2)),
+		NewDefaultPeriod(hour(5), hour(6)),
+	)
+
+	got := sequence.CutAt(hour(0))
+	want := []Period{
+		NewDefaultPeriod(hour(22).AddDate(0, 0, -1), hour(0)),
+		NewDefaultPeriod(hour(0), hour(2)),
+		NewDefaultPeriod(hour(5), hour(6)),
+	}
+
+	assert.Equal(t, len(want), got.Count())
+	for i := range want {
+		assert.True(t, want[i].Equals(got.Get(i)), "%v != %v", want[i], got.Get(i))
+	}
+
+	got = sequence.CutAtBoundedBy(ExcludeStartIncludeEnd, hour(1))
+	assert.True(t, NewPeriod(hour(22).AddDate(0, 0, -1), hour(1), IncludeAll).Equals(got.Get(0)))
+	assert.True(t, NewPeriod(hour(1), hour(2), ExcludeAll).Equals(got.Get(1)))
+
+	assert.True(t, NewDefaultPeriod(hour(0), hour(6)).SplitAt(hour(3)).Get(1).Equals(NewDefaultPeriod(hour(3), hour(6))))
+}
@@ -0,0 +1,439 @@
+package period
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"strings"
+	"time"
+)
+
+var svgPalette = []string{"#4e79a7", "#59a14f", "#f28e2b", "#b07aa1", "#76b7b2", "#edc948"}
+
+const (
+	svgIntersectionColor = "#e15759"
+	svgGapColor          = "#9c9c9c"
+)
+
+type svgTickStep struct {
+	duration time.Duration
+	days     int
+	months   int
+	layout   string
+}
+
+// svgTickSteps tick granularities from the finest to the coarsest, the first one producing few enough ticks wins
+var svgTickSteps = []svgTickStep{
+	{duration: time.Second, layout: "15:04:05"},
+	{duration: 5 * time.Second, layout: "15:04:05"},
+	{duration: 15 * time.Second, layout: "15:04:05"},
+	{duration: 30 * time.Second, layout: "15:04:05"},
+	{duration: time.Minute, layout: "15:04"},
+	{duration: 5 * time.Minute, layout: "15:04"},
+	{duration: 15 * time.Minute, layout: "15:04"},
+	{duration: 30 * time.Minute, layout: "15:04"},
+	{duration: time.Hour, layout: "15:04"},
+	{duration: 3 * time.Hour, layout: "15:04"},
+	{duration: 6 * time.Hour, layout: "15:04"},
+	{duration: 12 * time.Hour, layout: "Jan 2 15:04"},
+	{days: 1, layout: "Jan 2"},
+	{days: 2, layout: "Jan 2"},
+	{days: 7, layout: "Jan 2"},
+	{months: 1, layout: "Jan 2006"},
+	{months: 3, layout: "Jan 2006"},
+	{months: 6, layout: "Jan 2006"},
+	{months: 12, layout: "2006"},
+	{months: 24, layout: "2006"},
+	{months: 60, layout: "2006"},
+	{months: 120, layout: "2006"},
+	{months: 600, layout: "2006"},
+	{months: 1200, layout: "2006"},
+}
+
+func (s svgTickStep) approximate() time.Duration {
+	switch {
+	case s.months > 0:
+		return time.Duration(s.months) * 30 * 24 * time.Hour
+	case s.days > 0:
+		return time.Duration(s.days) * 24 * time.Hour
+	default:
+		return s.duration
+	}
+}
+
+func (s svgTickStep) first(date time.Time) time.Time {
+	switch {
+	case s.months > 0:
+		months := date.Year()*12 + int(date.Month()) - 1
+		first := time.Date(months/12, time.Month(months%12+1), 1, 0, 0, 0, 0, date.Location())
+		if first.Before(date) {
+			months++
+		}
+		if remainder := months % s.months; remainder != 0 {
+			months += s.months - remainder
+		}
+		return time.Date(months/12, time.Month(months%12+1), 1, 0, 0, 0, 0, date.Location())
+	case s.days > 0:
+		midnight := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+		if midnight.Before(date) {
+			midnight = midnight.AddDate(0, 0, 1)
+		}
+		return midnight
+	default:
+		return ceilTime(date, s.duration)
+	}
+}
+
+func (s svgTickStep) next(date time.Time) time.Time {
+	switch {
+	case s.months > 0:
+		return date.AddDate(0, s.months, 0)
+	case s.days > 0:
+		return date.AddDate(0, 0, s.days)
+	default:
+		return date.Add(s.duration)
+	}
+}
+
+func svgTicks(boundaries Period, maxTicks int) ([]time.Time, string) {
+	total := boundaries.GetDateInterval()
+	step := svgTickSteps[len(svgTickSteps)-1]
+
+	for _, candidate := range svgTickSteps {
+		if total/candidate.approximate() < time.Duration(maxTicks) {
+			step = candidate
+			break
+		}
+	}
+
+	var ticks []time.Time
+	for tick := step.first(boundaries.startDate); !tick.After(boundaries.endDate); tick = step.next(tick) {
+		ticks = append(ticks, tick)
+	}
+
+	return ticks, step.layout
+}
+
+type SVGTimelineConfig struct {
+	width                  int
+	laneHeight             int
+	labelWidth             int
+	padding                int
+	maxTicks               int
+	colors                 []string
+	location               *time.Location
+	highlightIntersections bool
+	highlightGaps          bool
+	disableLegend          bool
+}
+
+func NewSVGTimelineConfig() SVGTimelineConfig {
+	return SVGTimelineConfig{
+		width:                  800,
+		laneHeight:             30,
+		labelWidth:             120,
+		padding:                10,
+		maxTicks:               10,
+		colors:                 svgPalette,
+		highlightIntersections: true,
+		highlightGaps:          true,
+	}
+}
+
+func (c SVGTimelineConfig) WithWidth(width int) SVGTimelineConfig {
+	if width > 0 {
+		c.width = width
+	}
+
+	return c
+}
+
+func (c SVGTimelineConfig) WithLaneHeight(laneHeight int) SVGTimelineConfig {
+	if laneHeight > 0 {
+		c.laneHeight = laneHeight
+	}
+
+	return c
+}
+
+func (c SVGTimelineConfig) WithLabelWidth(labelWidth int) SVGTimelineConfig {
+	if labelWidth >= 0 {
+		c.labelWidth = labelWidth
+	}
+
+	return c
+}
+
+func (c SVGTimelineConfig) WithMaxTicks(maxTicks int) SVGTimelineConfig {
+	if maxTicks > 1 {
+		c.maxTicks = maxTicks
+	}
+
+	return c
+}
+
+// WithColors sets the CSS colors used in turn for every lane
+func (c SVGTimelineConfig) WithColors(colors ...string) SVGTimelineConfig {
+	if len(colors) > 0 {
+		c.colors = append([]string{}, colors...)
+	}
+
+	return c
+}
+
+// WithLocation sets the location of the tick labels, defaults to the location of the earliest start date
+func (c SVGTimelineConfig) WithLocation(location *time.Location) SVGTimelineConfig {
+	c.location = location
+
+	return c
+}
+
+func (c SVGTimelineConfig) WithIntersections(highlight bool) SVGTimelineConfig {
+	c.highlightIntersections = highlight
+
+	return c
+}
+
+func (c SVGTimelineConfig) WithGaps(highlight bool) SVGTimelineConfig {
+	c.highlightGaps = highlight
+
+	return c
+}
+
+func (c SVGTimelineConfig) WithoutLegend() SVGTimelineConfig {
+	c.disableLegend = true
+
+	return c
+}
+
+type SVGTimeline struct {
+	config SVGTimelineConfig
+}
+
+func NewSVGTimeline(config SVGTimelineConfig) SVGTimeline {
+	return SVGTimeline{config: config}
+}
+
+type svgCanvas struct {
+	builder    strings.Builder
+	boundaries Period
+	left       float64
+	plotWidth  float64
+}
+
+func (c *svgCanvas) x(date time.Time) float64 {
+	total := c.boundaries.GetDateInterval()
+	if total <= 0 {
+		return c.left
+	}
+
+	return c.left + float64(date.Sub(c.boundaries.startDate))/float64(total)*c.plotWidth
+}
+
+func (c *svgCanvas) printf(format string, args ...any) {
+	_, _ = fmt.Fprintf(&c.builder, format, args...)
+}
+
+func (s SVGTimeline) Render(w io.Writer, dataset Dataset) error {
+	config := s.config
+	boundaries, ok := dataset.Boundaries()
+	if !ok {
+		boundaries = Period{}
+	}
+
+	location := config.location
+	if location == nil {
+		location = boundaries.startDate.Location()
+	}
+	boundaries = NewPeriod(boundaries.startDate.In(location), boundaries.endDate.In(location), boundaries.GetBoundaryType())
+
+	// labels give way to the plot when they would not leave it any room
+	inner := max(config.width-2*config.padding, 0)
+	labelWidth := config.labelWidth
+	if labelWidth >= inner {
+		labelWidth = inner / 2
+	}
+
+	canvas := &svgCanvas{
+		boundaries: boundaries,
+		left:       float64(config.padding + labelWidth),
+		plotWidth:  float64(inner - labelWidth),
+	}
+
+	axisY := config.padding + dataset.Count()*config.laneHeight
+	height := axisY + 30 + config.padding
+	if !config.disableLegend {
+		height += s.legendHeight(dataset)
+	}
+
+	canvas.printf(
+		`<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="sans-serif" font-size="12">`+"\n",
+		config.width, height, config.width, height,
+	)
+	canvas.printf(`<rect width="%d" height="%d" fill="#ffffff"/>`+"\n", config.width, height)
+
+	for index, item := range dataset.items {
+		s.renderLane(canvas, index, item)
+	}
+
+	if ok {
+		s.renderAxis(canvas, axisY)
+	}
+
+	if !config.disableLegend {
+		s.renderLegend(canvas, dataset, axisY+30)
+	}
+
+	canvas.printf("</svg>\n")
+
+	_, err := io.WriteString(w, canvas.builder.String())
+
+	return err
+}
+
+func (s SVGTimeline) color(index int) string {
+	return html.EscapeString(s.config.colors[index%len(s.config.colors)])
+}
+
+func (s SVGTimeline) renderLane(canvas *svgCanvas, index int, item datasetItem) {
+	config := s.config
+	top := float64(config.padding + index*config.laneHeight)
+	barHeight := float64(config.laneHeight) * 0.6
+	barTop := top + (float64(config.laneHeight)-barHeight)/2
+	middle := top + float64(config.laneHeight)/2
+	color := s.color(index)
+
+	canvas.printf(`<g class="lane">` + "\n")
+	canvas.printf(
+		`<text x="%d" y="%.2f" dominant-baseline="middle">%s</text>`+"\n",
+		config.padding, middle, html.EscapeString(item.label),
+	)
+
+	// Gaps and Intersections sort in place, work on a copy to leave the dataset untouched
+	sequence := NewSequence(append([]Period{}, item.sequence.intervals...)...)
+
+	if config.highlightGaps {
+		for _, gap := range sequence.Gaps().intervals {
+			canvas.printf(
+				`<rect class="gap" x="%.2f" y="%.2f" width="%.2f" height="%.2f" fill="none" stroke="%s" stroke-dasharray="4 2"/>`+"\n",
+				canvas.x(gap.startDate), barTop, canvas.x(gap.endDate)-canvas.x(gap.startDate), barHeight, svgGapColor,
+			)
+		}
+	}
+
+	for _, period := range sequence.intervals {
+		canvas.printf(
+			`<rect class="period" x="%.2f" y="%.2f" width="%.2f" height="%.2f" fill="%s" fill-opacity="0.8"/>`+"\n",
+			canvas.x(period.startDate), barTop, canvas.x(period.endDate)-canvas.x(period.startDate), barHeight, color,
+		)
+	}
+
+	if config.highlightIntersections {
+		for _, intersection := range sequence.Intersections().intervals {
+			canvas.printf(
+				`<rect class="intersection" x="%.2f" y="%.2f" width="%.2f" height="%.2f" fill="%s" fill-opacity="0.6"/>`+"\n",
+				canvas.x(intersection.startDate), barTop, canvas.x(intersection.endDate)-canvas.x(intersection.startDate),
+				barHeight, svgIntersectionColor,
+			)
+		}
+	}
+
+	for _, period := range sequence.intervals {
+		boundaryType := period.GetBoundaryType()
+		s.renderBound(canvas, canvas.x(period.startDate), middle, color, boundaryIsStartIncluded(boundaryType))
+		s.renderBound(canvas, canvas.x(period.endDate), middle, color, boundaryIsEndIncluded(boundaryType))
+	}
+
+	canvas.printf("</g>\n")
+}
+
+// renderBound draws an included bound as a filled dot and an excluded bound as a hollow one
+func (s SVGTimeline) renderBound(canvas *svgCanvas, x, y float64, color string, included bool) {
+	if included {
+		canvas.printf(`<circle class="included" cx="%.2f" cy="%.2f" r="4" fill="%s"/>`+"\n", x, y, color)
+		return
+	}
+
+	canvas.printf(
+		`<circle class="excluded" cx="%.2f" cy="%.2f" r="4" fill="#ffffff" stroke="%s" stroke-width="2"/>`+"\n",
+		x, y, color,
+	)
+}
+
+func (s SVGTimeline) renderAxis(canvas *svgCanvas, axisY int) {
+	canvas.printf(`<g class="axis">` + "\n")
+	canvas.printf(
+		`<line x1="%.2f" y1="%d" x2="%.2f" y2="%d" stroke="#333333"/>`+"\n",
+		canvas.left, axisY, canvas.left+canvas.plotWidth, axisY,
+	)
+
+	ticks, layout := svgTicks(canvas.boundaries, s.config.maxTicks)
+	for _, tick := range ticks {
+		x := canvas.x(tick)
+		canvas.printf(`<line x1="%.2f" y1="%d" x2="%.2f" y2="%d" stroke="#333333"/>`+"\n", x, axisY, x, axisY+5)
+		canvas.printf(
+			`<text x="%.2f" y="%d" text-anchor="middle">%s</text>`+"\n",
+			x, axisY+18, html.EscapeString(tick.Format(layout)),
+		)
+	}
+
+	canvas.printf("</g>\n")
+}
+
+func (s SVGTimeline) legendEntries(dataset Dataset) int {
+	entries := dataset.Count() + 2
+
+	if s.config.highlightIntersections {
+		entries++
+	}
+
+	if s.config.highlightGaps {
+		entries++
+	}
+
+	return entries
+}
+
+func (s SVGTimeline) legendHeight(dataset Dataset) int {
+	return s.legendEntries(dataset) * 18
+}
+
+func (s SVGTimeline) renderLegend(canvas *svgCanvas, dataset Dataset, top int) {
+	x := s.config.padding
+	y := top
+
+	canvas.printf(`<g class="legend">` + "\n")
+
+	label := func(text string) {
+		canvas.printf(`<text x="%d" y="%d" dominant-baseline="middle">%s</text>`+"\n", x+20, y+6, html.EscapeString(text))
+		y += 18
+	}
+
+	for index, text := range dataset.Labels() {
+		canvas.printf(`<rect x="%d" y="%d" width="12" height="12" fill="%s"/>`+"\n", x, y, s.color(index))
+		label(text)
+	}
+
+	if s.config.highlightIntersections {
+		canvas.printf(
+			`<rect x="%d" y="%d" width="12" height="12" fill="%s" fill-opacity="0.6"/>`+"\n", x, y, svgIntersectionColor,
+		)
+		label("Intersections")
+	}
+
+	if s.config.highlightGaps {
+		canvas.printf(
+			`<rect x="%d" y="%d" width="12" height="12" fill="none" stroke="%s" stroke-dasharray="4 2"/>`+"\n",
+			x, y, svgGapColor,
+		)
+		label("Gaps")
+	}
+
+	canvas.printf(`<circle cx="%d" cy="%d" r="4" fill="#333333"/>`+"\n", x+6, y+6)
+	label("Included bound")
+
+	canvas.printf(`<circle cx="%d" cy="%d" r="4" fill="#ffffff" stroke="#333333" stroke-width="2"/>`+"\n", x+6, y+6)
+	label("Excluded bound")
+
+	canvas.printf("</g>\n")
+}
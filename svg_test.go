@@ -0,0 +1,196 @@
+package period
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSVGTicks(t *testing.T) {
+	tests := []struct {
+		name       string
+		boundaries Period
+		maxTicks   int
+		wantFirst  time.Time
+		wantCount  int
+		wantLayout string
+	}{
+		{
+			name: "SVGTicks_WithHours",
+			boundaries: NewDefaultPeriod(
+				time.Date(2023, 1, 1, 8, 20, 0, 0, time.UTC),
+				time.Date(2023, 1, 1, 17, 0, 0, 0, time.UTC),
+			),
+			maxTicks:   10,
+			wantFirst:  time.Date(2023, 1, 1, 9, 0, 0, 0, time.UTC),
+			wantCount:  9,
+			wantLayout: "15:04",
+		},
+		{
+			name: "SVGTicks_WithDays",
+			boundaries: NewDefaultPeriod(
+				time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC),
+				time.Date(2023, 1, 7, 0, 0, 0, 0, time.UTC),
+			),
+			maxTicks:   10,
+			wantFirst:  time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC),
+			wantCount:  6,
+			wantLayout: "Jan 2",
+		},
+		{
+			name: "SVGTicks_WithMonths",
+			boundaries: NewDefaultPeriod(
+				time.Date(2023, 1, 15, 0, 0, 0, 0, time.UTC),
+				time.Date(2023, 9, 1, 0, 0, 0, 0, time.UTC),
+			),
+			maxTicks:   10,
+			wantFirst:  time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC),
+			wantCount:  8,
+			wantLayout: "Jan 2006",
+		},
+		{
+			name: "SVGTicks_WithQuarters",
+			boundaries: NewDefaultPeriod(
+				time.Date(2023, 2, 15, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC),
+			),
+			maxTicks:   10,
+			wantFirst:  time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC),
+			wantCount:  7,
+			wantLayout: "Jan 2006",
+		},
+		{
+			name: "SVGTicks_WithYears",
+			boundaries: NewDefaultPeriod(
+				time.Date(2001, 6, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			),
+			maxTicks:   10,
+			wantFirst:  time.Date(2002, 1, 1, 0, 0, 0, 0, time.UTC),
+			wantCount:  10,
+			wantLayout: "2006",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				ticks, layout := svgTicks(tt.boundaries, tt.maxTicks)
+				assert.Equal(t, tt.wantLayout, layout)
+				assert.Equal(t, tt.wantCount, len(ticks))
+				assert.True(t, tt.wantFirst.Equal(ticks[0]), "%s != %s", tt.wantFirst, ticks[0])
+			},
+		)
+	}
+}
+
+func TestSVGTimelineRender(t *testing.T) {
+	day := func(d int) time.Time {
+		return time.Date(2023, 1, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	dataset := NewDataset().
+		AppendSequence(
+			"On-call <ops>", NewSequence(
+				NewDefaultPeriod(day(1), day(4)),
+				NewIncludeAllPeriod(day(3), day(5)),
+				NewDefaultPeriod(day(7), day(9)),
+			),
+		).
+		Append("Approver", NewPeriod(day(2), day(8), ExcludeAll))
+
+	tests := []struct {
+		name        string
+		config      SVGTimelineConfig
+		contains    []string
+		notContains []string
+	}{
+		{
+			name:   "Render_WithDefaultConfig",
+			config: NewSVGTimelineConfig(),
+			contains: []string{
+				`<svg xmlns="http://www.w3.org/2000/svg" width="800"`,
+				`On-call &lt;ops&gt;`,
+				`<rect class="period" x="130.00"`,
+				`class="intersection"`,
+				`class="gap"`,
+				`class="included"`,
+				`class="excluded"`,
+				`>Jan 2</text>`,
+				`>Intersections</text>`,
+				`>Gaps</text>`,
+				`>Excluded bound</text>`,
+				"</svg>\n",
+			},
+		},
+		{
+			name:   "Render_WithoutHighlightsAndLegend",
+			config: NewSVGTimelineConfig().WithIntersections(false).WithGaps(false).WithoutLegend().WithColors("red"),
+			contains: []string{
+				`fill="red"`,
+			},
+			notContains: []string{
+				`class="intersection"`,
+				`class="gap"`,
+				`class="legend"`,
+			},
+		},
+		{
+			name:   "Render_WithCustomSize",
+			config: NewSVGTimelineConfig().WithWidth(400).WithLaneHeight(20).WithLabelWidth(0).WithMaxTicks(3),
+			contains: []string{
+				`width="400"`,
+				`<rect class="period" x="10.00"`,
+			},
+		},
+		{
+			name:   "Render_WithLabelsWiderThanChart",
+			config: NewSVGTimelineConfig().WithWidth(100),
+			contains: []string{
+				`width="100"`,
+				`<rect class="period" x="50.00"`,
+			},
+			notContains: []string{
+				`width="-`,
+				`x="-`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				var buffer bytes.Buffer
+				assert.NoError(t, NewSVGTimeline(tt.config).Render(&buffer, dataset))
+
+				got := buffer.String()
+				for _, want := range tt.contains {
+					assert.Contains(t, got, want)
+				}
+				for _, unwanted := range tt.notContains {
+					assert.NotContains(t, got, unwanted)
+				}
+			},
+		)
+	}
+}
+
+func TestSVGTimelineRenderKeepsDataset(t *testing.T) {
+	sequence := NewSequence(
+		NewDefaultPeriod(time.Date(2023, 1, 5, 0, 0, 0, 0, time.UTC), time.Date(2023, 1, 6, 0, 0, 0, 0, time.UTC)),
+		NewDefaultPeriod(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)),
+	)
+
+	var buffer bytes.Buffer
+	assert.NoError(t, NewSVGTimeline(NewSVGTimelineConfig()).Render(&buffer, NewDataset().AppendSequence("A", sequence)))
+	assert.Equal(t, 5, sequence.Get(0).GetStartDate().Day())
+}
+
+func TestSVGTimelineRenderEmptyDataset(t *testing.T) {
+	var buffer bytes.Buffer
+	assert.NoError(t, NewSVGTimeline(NewSVGTimelineConfig()).Render(&buffer, NewDataset()))
+	assert.True(t, strings.HasPrefix(buffer.String(), "<svg"))
+	assert.NotContains(t, buffer.String(), `class="axis"`)
+}